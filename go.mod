@@ -321,6 +321,7 @@ require (
 	go.opentelemetry.io/otel v1.40.0
 	golang.org/x/crypto v0.48.0
 	golang.org/x/text v0.34.0
+	golang.org/x/time v0.15.0
 	golang.org/x/tools v0.42.0
 	gopkg.in/dnaeon/go-vcr.v4 v4.0.6
 )
@@ -0,0 +1,542 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListTestCase is a test of a list resource that is run against a fake AWS API,
+// so that listing is exercised without making requests to AWS.
+type ListTestCase struct {
+	// ListResourceType is the type of the list resource, for example aws_cloudwatch_log_group.
+	ListResourceType string
+
+	// Region is the provider's Region. Defaults to us-west-2.
+	Region string
+
+	// ProviderConfig sets provider arguments, for example list_rate_limits, in addition to the Region and credentials.
+	ProviderConfig map[string]tftypes.Value
+
+	// Config sets the list resource's query fields. Query fields that aren't set are null.
+	Config map[string]tftypes.Value
+
+	IncludeResource bool
+	Limit           int64
+
+	// API answers the AWS API calls made while listing.
+	API ListFakeAPI
+
+	// Checks are run against the results, and the AWS API calls made, once listing has finished.
+	Checks []ListCheck
+}
+
+// ListFakeAPI answers the AWS API calls made in a ListTest with canned responses.
+type ListFakeAPI struct {
+	// Responses are keyed by operation name, for example DescribeLogGroups.
+	// Each call to an operation is answered with the operation's next response, and its last response is repeated
+	// once the others have been used. Operations of different services with the same name share responses.
+	// STS GetCallerIdentity, which is called when the provider is configured, is answered for account 123456789012
+	// unless it has responses.
+	Responses map[string][]ListFakeResponse
+
+	// Fallback answers calls to operations without responses. If nil, such calls fail the test.
+	Fallback *ListFakeResponse
+}
+
+// ListFakeResponse is a canned response to an AWS API call.
+type ListFakeResponse struct {
+	// StatusCode defaults to 200, or to 400 for errors.
+	StatusCode int
+
+	// ErrorCode, if set, makes the response an error with this code, in the calling service's protocol.
+	ErrorCode string
+
+	// Headers are added to the response, for example X-Amz-Bucket-Region.
+	Headers map[string]string
+
+	// Body is the response body, in the calling service's protocol: JSON for JSON protocol services, otherwise XML.
+	// It is ignored for errors.
+	Body string
+}
+
+// ListCall is an AWS API call made in a ListTest.
+type ListCall struct {
+	Operation string
+	Time      time.Time
+
+	// URL and Body are those of the call's request, for example to check the input to a paginated operation.
+	URL  *url.URL
+	Body string
+}
+
+// ListResult is a result returned by a list resource in a list resource test.
+type ListResult struct {
+	DisplayName string
+
+	// Tags are the resource's tags. They are only returned when resources are included in the results.
+	Tags map[string]string
+}
+
+// ListResults are the results returned by a list resource in a ListTest, and the AWS API calls made while listing, in order.
+type ListResults struct {
+	Results []ListResult
+	Calls   []ListCall
+}
+
+// ListCheck checks the results of a ListTest.
+type ListCheck func(t *testing.T, results ListResults)
+
+// ListTest runs a list resource against a fake AWS API, and fails the test if any result has an error or any check fails.
+//
+// As environment variables that change the provider's configuration are unset, ListTest can't be used in parallel tests.
+func ListTest(ctx context.Context, t *testing.T, c ListTestCase) {
+	t.Helper()
+
+	for _, k := range listUnsetEnvVars {
+		t.Setenv(k, "")
+	}
+
+	providerConfig := map[string]tftypes.Value{
+		names.AttrAccessKey:       tftypes.NewValue(tftypes.String, servicemocks.MockStaticAccessKey),
+		names.AttrRegion:          tftypes.NewValue(tftypes.String, listRegion(c.Region)),
+		names.AttrSecretKey:       tftypes.NewValue(tftypes.String, servicemocks.MockStaticSecretKey),
+		"max_retries":             tftypes.NewValue(tftypes.Number, 1),
+		"skip_metadata_api_check": tftypes.NewValue(tftypes.String, "true"),
+	}
+	maps.Copy(providerConfig, c.ProviderConfig)
+
+	api := &listFakeTransport{
+		t:         t,
+		api:       c.API,
+		responses: make(map[string]int),
+	}
+	results := ListResults{
+		Results: listResources(ctx, t, &http.Client{Transport: api}, providerConfig, listRequest{
+			listResourceType: c.ListResourceType,
+			config:           c.Config,
+			includeResource:  c.IncludeResource,
+			limit:            c.Limit,
+		}),
+	}
+
+	api.mutex.Lock()
+	results.Calls = slices.Clone(api.calls)
+	api.mutex.Unlock()
+
+	for _, check := range c.Checks {
+		check(t, results)
+	}
+}
+
+// ExpectListResultCount returns a check that the list resource returns n results.
+func ExpectListResultCount(n int) ListCheck {
+	return func(t *testing.T, results ListResults) {
+		t.Helper()
+
+		if got := len(results.Results); got != n {
+			t.Errorf("expected %d results, got %d", n, got)
+		}
+	}
+}
+
+// ExpectListDisplayNames returns a check that the list resource returns results with these display names, in order.
+func ExpectListDisplayNames(displayNames ...string) ListCheck {
+	return func(t *testing.T, results ListResults) {
+		t.Helper()
+
+		var got []string
+		for _, v := range results.Results {
+			got = append(got, v.DisplayName)
+		}
+
+		if diff := cmp.Diff(got, displayNames, cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("unexpected display names diff (+wanted, -got): %s", diff)
+		}
+	}
+}
+
+// ExpectListResults returns a check that the list resource returns these results, including their tags, in order.
+func ExpectListResults(expected ...ListResult) ListCheck {
+	return func(t *testing.T, results ListResults) {
+		t.Helper()
+
+		if diff := cmp.Diff(results.Results, expected, cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("unexpected results diff (+wanted, -got): %s", diff)
+		}
+	}
+}
+
+// ExpectListCallCount returns a check that the named operation is called n times while listing.
+func ExpectListCallCount(operation string, n int) ListCheck {
+	return func(t *testing.T, results ListResults) {
+		t.Helper()
+
+		if got := len(listCallsTo(results.Calls, operation)); got != n {
+			t.Errorf("expected %d %s calls, got %d", n, operation, got)
+		}
+	}
+}
+
+// ExpectListCallRate returns a check that calls to the named operation are no faster than rate, in calls per second,
+// once a burst of burst calls has been made. That is, the limits of a list resource's rate limiter were honored.
+func ExpectListCallRate(operation string, rate float64, burst int) ListCheck {
+	return func(t *testing.T, results ListResults) {
+		t.Helper()
+
+		calls := listCallsTo(results.Calls, operation)
+		if len(calls) <= burst {
+			t.Errorf("expected more than %d %s calls to check their rate, got %d", burst, operation, len(calls))
+			return
+		}
+
+		for i := burst; i < len(calls); i++ {
+			// The first call is made slightly after the limiter first allows one, so a little slack is allowed.
+			minElapsed := time.Duration(float64(i-burst+1) / rate * float64(time.Second) * 0.9)
+			if elapsed := calls[i].Time.Sub(calls[0].Time); elapsed < minElapsed {
+				t.Errorf("%s call %d made %s after the first, expected at least %s", operation, i+1, elapsed, minElapsed)
+			}
+		}
+	}
+}
+
+func listCallsTo(calls []ListCall, operation string) []ListCall {
+	return slices.DeleteFunc(slices.Clone(calls), func(v ListCall) bool {
+		return v.Operation != operation
+	})
+}
+
+// listUnsetEnvVars are the environment variables that are unset when a list resource is tested.
+var listUnsetEnvVars = []string{
+	"AWS_CA_BUNDLE",
+	"AWS_CONFIG_FILE",
+	"AWS_ENDPOINT_URL",
+	"AWS_PROFILE",
+	"AWS_SHARED_CREDENTIALS_FILE",
+	"AWS_USE_FIPS_ENDPOINT",
+}
+
+// listRegion returns the provider's Region in a list resource test.
+func listRegion(region string) string {
+	if region == "" {
+		return "us-west-2" //lintignore:AWSAT003
+	}
+	return region
+}
+
+// listRequest is a request to a list resource in a list resource test.
+type listRequest struct {
+	listResourceType string
+	config           map[string]tftypes.Value
+	includeResource  bool
+	limit            int64
+}
+
+// listResources configures the provider, making AWS API calls with httpClient, runs the list resource and returns its results.
+// The test fails if configuring the provider or listing returns an error.
+func listResources(ctx context.Context, t *testing.T, httpClient *http.Client, providerConfig map[string]tftypes.Value, request listRequest) []ListResult {
+	t.Helper()
+
+	providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatalf("creating provider: %s", err)
+	}
+	primary.Meta().(*conns.AWSClient).SetHTTPClient(ctx, httpClient)
+
+	server, ok := providerServerFactory().(tfprotov5.ProviderServerWithListResource)
+	if !ok {
+		t.Fatal("provider server doesn't support list resources")
+	}
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("getting provider schema: %s", err)
+	}
+	checkListDiagnostics(t, "getting provider schema", schemas.Diagnostics)
+
+	listResourceSchema, ok := schemas.ListResourceSchemas[request.listResourceType]
+	if !ok {
+		t.Fatalf("list resource type %q not found", request.listResourceType)
+	}
+	resourceSchema, ok := schemas.ResourceSchemas[request.listResourceType]
+	if !ok {
+		t.Fatalf("resource type %q not found", request.listResourceType)
+	}
+
+	config, err := listConfig(schemas.Provider, providerConfig)
+	if err != nil {
+		t.Fatalf("provider configuration: %s", err)
+	}
+	configured, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		TerraformVersion: "1.14.0",
+		Config:           config,
+	})
+	if err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+	checkListDiagnostics(t, "configuring provider", configured.Diagnostics)
+
+	config, err = listConfig(listResourceSchema, request.config)
+	if err != nil {
+		t.Fatalf("list resource configuration: %s", err)
+	}
+	stream, err := server.ListResource(ctx, &tfprotov5.ListResourceRequest{
+		TypeName:        request.listResourceType,
+		Config:          config,
+		IncludeResource: request.includeResource,
+		Limit:           request.limit,
+	})
+	if err != nil {
+		t.Fatalf("listing %s: %s", request.listResourceType, err)
+	}
+
+	var results []ListResult
+	for result := range stream.Results {
+		checkListDiagnostics(t, fmt.Sprintf("listing %s", request.listResourceType), result.Diagnostics)
+
+		v := ListResult{
+			DisplayName: result.DisplayName,
+		}
+		if request.includeResource {
+			tags, err := listResourceTags(resourceSchema, result.Resource)
+			if err != nil {
+				t.Fatalf("reading tags of %q: %s", result.DisplayName, err)
+			}
+			v.Tags = tags
+		}
+		results = append(results, v)
+	}
+
+	return results
+}
+
+// checkListDiagnostics fails the test if diags has any errors.
+func checkListDiagnostics(t *testing.T, operation string, diags []*tfprotov5.Diagnostic) {
+	t.Helper()
+
+	for _, diag := range diags {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("%s: %s: %s", operation, diag.Summary, diag.Detail)
+		}
+	}
+}
+
+// listConfig returns the configuration of schema in which the specified attributes are set.
+// Other attributes are null, and nested blocks are empty.
+func listConfig(schema *tfprotov5.Schema, values map[string]tftypes.Value) (*tfprotov5.DynamicValue, error) {
+	typ := schema.ValueType()
+
+	attrs := make(map[string]tftypes.Value)
+	for _, attr := range schema.Block.Attributes {
+		attrs[attr.Name] = tftypes.NewValue(attr.ValueType(), nil)
+	}
+	for _, block := range schema.Block.BlockTypes {
+		switch block.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList, tfprotov5.SchemaNestedBlockNestingModeSet:
+			attrs[block.TypeName] = tftypes.NewValue(block.ValueType(), []tftypes.Value{})
+		case tfprotov5.SchemaNestedBlockNestingModeMap:
+			attrs[block.TypeName] = tftypes.NewValue(block.ValueType(), map[string]tftypes.Value{})
+		default:
+			attrs[block.TypeName] = tftypes.NewValue(block.ValueType(), nil)
+		}
+	}
+	maps.Copy(attrs, values)
+
+	if err := tftypes.ValidateValue(typ, attrs); err != nil {
+		return nil, err
+	}
+
+	v, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, attrs))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// listResourceTags returns the tags of a listed resource.
+func listResourceTags(schema *tfprotov5.Schema, resource *tfprotov5.DynamicValue) (map[string]string, error) {
+	if resource == nil {
+		return nil, nil
+	}
+
+	v, err := resource.Unmarshal(schema.ValueType())
+	if err != nil {
+		return nil, err
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return nil, err
+	}
+
+	var values map[string]tftypes.Value
+	if err := attrs[names.AttrTags].As(&values); err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string, len(values))
+	for k, v := range values {
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil, err
+		}
+		tags[k] = s
+	}
+
+	return tags, nil
+}
+
+// listFakeTransport is an http.RoundTripper that answers AWS API calls from a ListFakeAPI and records them.
+// The operation called is identified from the AWS SDK's request context, so every protocol is handled alike.
+type listFakeTransport struct {
+	t   *testing.T
+	api ListFakeAPI
+
+	mutex     sync.Mutex
+	responses map[string]int
+	calls     []ListCall
+}
+
+func (f *listFakeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	operation := awsmiddleware.GetOperationName(req.Context())
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.calls = append(f.calls, ListCall{
+		Operation: operation,
+		Time:      time.Now(),
+		URL:       req.URL,
+		Body:      string(body),
+	})
+
+	response, ok := f.response(operation)
+	if !ok {
+		f.t.Errorf("unexpected %s call", operation)
+		response = ListFakeResponse{
+			ErrorCode: "UnexpectedCall",
+		}
+	}
+
+	return response.httpResponse(req), nil
+}
+
+// response returns the next response to a call to the named operation.
+func (f *listFakeTransport) response(operation string) (ListFakeResponse, bool) {
+	responses, ok := f.api.Responses[operation]
+	if !ok || len(responses) == 0 {
+		switch {
+		case operation == "GetCallerIdentity":
+			return ListFakeResponse{
+				Body: listFakeCallerIdentity,
+			}, true
+		case f.api.Fallback != nil:
+			return *f.api.Fallback, true
+		default:
+			return ListFakeResponse{}, false
+		}
+	}
+
+	i := min(f.responses[operation], len(responses)-1)
+	f.responses[operation]++
+
+	return responses[i], true
+}
+
+const listFakeCallerIdentity = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/test</Arn>
+    <UserId>AIDAEXAMPLEEXAMPLE123</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+
+// httpResponse returns the response to req.
+// JSON protocol requests are identified by their X-Amz-Target header, and query protocol requests by their form body.
+func (r ListFakeResponse) httpResponse(req *http.Request) *http.Response {
+	json := req.Header.Get("X-Amz-Target") != ""
+	query := strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded")
+
+	header := make(http.Header)
+	if json {
+		header.Set("Content-Type", "application/x-amz-json-1.1")
+	} else {
+		header.Set("Content-Type", "text/xml")
+	}
+	header.Set("X-Amzn-Requestid", "01234567-89ab-cdef-0123-456789abcdef")
+
+	statusCode, body := r.StatusCode, r.Body
+	if r.ErrorCode != "" {
+		if statusCode == 0 {
+			statusCode = http.StatusBadRequest
+		}
+
+		// REST JSON protocol errors are identified by this header, whatever the body.
+		header.Set("X-Amzn-Errortype", r.ErrorCode)
+		switch {
+		case json:
+			body = fmt.Sprintf(`{"__type":%q,"message":"fake error"}`, r.ErrorCode)
+		case query:
+			body = fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>fake error</Message></Error></ErrorResponse>`, r.ErrorCode)
+		default:
+			body = fmt.Sprintf(`<Error><Code>%s</Code><Message>fake error</Message></Error>`, r.ErrorCode)
+		}
+	}
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	if req.Method == http.MethodHead {
+		body = ""
+	}
+
+	for k, v := range r.Headers {
+		header.Set(k, v)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
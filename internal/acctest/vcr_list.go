@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+// ListReplayTestCase is a test of a list resource that is run against the interactions recorded in a go-vcr cassette,
+// so that listing is exercised without making requests to AWS.
+type ListReplayTestCase struct {
+	// CassetteName is the path of the cassette, without its .yaml extension, relative to the test's package.
+	CassetteName string
+
+	// ListResourceType is the type of the list resource, for example aws_cloudwatch_log_group.
+	ListResourceType string
+
+	// Region is the provider's Region. Defaults to us-west-2.
+	Region string
+
+	// Config sets the list resource's query fields. Query fields that aren't set are null.
+	Config map[string]tftypes.Value
+
+	IncludeResource bool
+	Limit           int64
+
+	// ExpectedResults are the results that the list resource is expected to return, in order.
+	ExpectedResults []ListResult
+}
+
+// ListReplayTest runs a list resource against the interactions recorded in a go-vcr cassette, and
+// fails the test if any result has an error or the results aren't the expected results.
+//
+// Interactions are replayed with static credentials, and any request that wasn't recorded fails.
+// As environment variables that change the provider's configuration are unset, ListReplayTest can't be used in parallel tests.
+// When VCR_MODE is RECORD_ONLY, interactions are instead recorded to the cassette, with credentials from the environment.
+func ListReplayTest(ctx context.Context, t *testing.T, c ListReplayTestCase) {
+	t.Helper()
+
+	providerConfig := map[string]tftypes.Value{
+		names.AttrRegion:          tftypes.NewValue(tftypes.String, listRegion(c.Region)),
+		"max_retries":             tftypes.NewValue(tftypes.Number, 1),
+		"skip_metadata_api_check": tftypes.NewValue(tftypes.String, "true"),
+	}
+
+	mode := recorder.ModeReplayOnly
+	if v, err := vcr.Mode(); err == nil && v == recorder.ModeRecordOnly {
+		mode = v
+	} else {
+		providerConfig[names.AttrAccessKey] = tftypes.NewValue(tftypes.String, servicemocks.MockStaticAccessKey)
+		providerConfig[names.AttrSecretKey] = tftypes.NewValue(tftypes.String, servicemocks.MockStaticSecretKey)
+
+		// Interactions are replayed the same way whatever the environment.
+		for _, k := range listUnsetEnvVars {
+			t.Setenv(k, "")
+		}
+	}
+
+	httpClient := cleanhttp.DefaultPooledClient()
+	r, err := recorder.New(c.CassetteName,
+		recorder.WithHook(vcrSensitiveHeaderHook, recorder.AfterCaptureHook),
+		recorder.WithMatcher(vcrMatcherFunc(ctx)),
+		recorder.WithMode(mode),
+		recorder.WithRealTransport(httpClient.Transport),
+		recorder.WithSkipRequestLatency(true),
+	)
+	if err != nil {
+		t.Fatalf("creating VCR recorder: %s", err)
+	}
+	t.Cleanup(func() {
+		if err := r.Stop(); err != nil {
+			t.Errorf("stopping VCR recorder: %s", err)
+		}
+	})
+
+	// The provider is configured with the recorder's HTTP client.
+	httpClient.Transport = r
+	results := listResources(ctx, t, httpClient, providerConfig, listRequest{
+		listResourceType: c.ListResourceType,
+		config:           c.Config,
+		includeResource:  c.IncludeResource,
+		limit:            c.Limit,
+	})
+
+	if diff := cmp.Diff(results, c.ExpectedResults, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("unexpected results diff (+wanted, -got): %s", diff)
+	}
+}
@@ -5,6 +5,7 @@ package backoff
 
 import (
 	"context"
+	"math/rand/v2"
 	"time"
 
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -48,6 +49,26 @@ func FixedDelay(delay time.Duration) DelayFunc {
 	}
 }
 
+// ExponentialJitterDelay returns a delay with full jitter. The first attempt has no delay (0), and subsequent attempts
+// use a random delay of up to baseDelay, doubling with each attempt, but never more than maxDelay.
+func ExponentialJitterDelay(baseDelay, maxDelay time.Duration) DelayFunc {
+	return func(n uint) time.Duration {
+		if n == 0 {
+			return 0
+		}
+
+		delay := maxDelay
+		if n <= 32 {
+			delay = min(baseDelay<<(n-1), maxDelay)
+		}
+		if delay <= 0 {
+			return 0
+		}
+
+		return rand.N(delay + 1) //nolint:gosec // Jitter doesn't need a cryptographically secure source.
+	}
+}
+
 // ZeroDelay returns 0 for all attempts.
 //
 // This DelayFunc should only be used for testing.
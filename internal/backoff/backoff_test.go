@@ -37,6 +37,32 @@ func TestDefaultSDKv2HelperRetryCompatibleDelay(t *testing.T) {
 	}
 }
 
+func TestExponentialJitterDelay(t *testing.T) {
+	t.Parallel()
+
+	delay := ExponentialJitterDelay(1*time.Second, 10*time.Second)
+	maxDelays := []time.Duration{
+		0,
+		1 * time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		8 * time.Second,
+		10 * time.Second,
+		10 * time.Second,
+	}
+	for i, maxDelay := range maxDelays {
+		for range 100 {
+			if got := delay.Next(uint(i)); got < 0 || got > maxDelay {
+				t.Errorf("attempt %d: expected delay between 0 and %s, got %s", i, maxDelay, got)
+			}
+		}
+	}
+
+	if got := delay.Next(1000); got < 0 || got > 10*time.Second {
+		t.Errorf("expected delay between 0 and 10s, got %s", got)
+	}
+}
+
 func TestDefaultSDKv2HelperRetryCompatibleDelayWithIncrementDelay(t *testing.T) {
 	t.Parallel()
 
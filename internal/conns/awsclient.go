@@ -16,6 +16,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -36,6 +38,7 @@ type AWSClient struct {
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	ignoreTagsConfig          *tftags.IgnoreConfig
+	listRateLimits            map[string]float64 // From provider configuration.
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
@@ -99,6 +102,52 @@ func (c *AWSClient) AccountID(context.Context) string {
 	return c.accountID
 }
 
+// RateLimitScope returns the scope in which list resources rate limit their calls:
+// the client's account and Region, with the rates from the provider configuration.
+func (c *AWSClient) RateLimitScope(ctx context.Context) ratelimit.Scope {
+	return ratelimit.Scope{
+		AccountID: c.AccountID(ctx),
+		Region:    c.Region(ctx),
+		Rates:     c.listRateLimits,
+	}
+}
+
+// WithAssumedRole returns a copy of the client for the specified account, whose API clients use the credentials of the IAM role
+// with the specified name in that account, assumed with the client's own credentials.
+// The role is assumed when credentials are first needed, so an error assuming it is returned by the first API call.
+func (c *AWSClient) WithAssumedRole(ctx context.Context, accountID, roleName string) *AWSClient {
+	roleARN := arn.ARN{
+		Partition: c.Partition(ctx),
+		Service:   "iam",
+		AccountID: accountID,
+		Resource:  "role/" + roleName,
+	}.String()
+
+	awsConfig := c.awsConfig.Copy()
+	awsConfig.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(c.STSClient(ctx), roleARN))
+
+	return &AWSClient{
+		accountID:                 accountID,
+		awsConfig:                 &awsConfig,
+		clients:                   make(map[string]map[string]any),
+		defaultTagsConfig:         c.defaultTagsConfig,
+		endpoints:                 c.endpoints,
+		httpClient:                c.httpClient,
+		ignoreTagsConfig:          c.ignoreTagsConfig,
+		listRateLimits:            c.listRateLimits,
+		logger:                    c.logger,
+		partition:                 c.partition,
+		randomnessSource:          c.randomnessSource,
+		servicePackages:           c.servicePackages,
+		s3OriginalRegion:          c.s3OriginalRegion,
+		s3UsePathStyle:            c.s3UsePathStyle,
+		s3USEast1RegionalEndpoint: c.s3USEast1RegionalEndpoint,
+		stsRegion:                 c.stsRegion,
+		tagPolicyConfig:           c.tagPolicyConfig,
+		terraformVersion:          c.terraformVersion,
+	}
+}
+
 // Partition returns the ID of the configured AWS partition.
 func (c *AWSClient) Partition(context.Context) string {
 	return c.partition.ID()
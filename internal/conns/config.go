@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tags/tagpolicy"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -43,6 +44,7 @@ type Config struct {
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	ListRateLimits                 map[string]float64
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
@@ -226,9 +228,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		c.TagPolicyConfig.RequiredTags = reqTags
 	}
 
+	if err := ratelimit.ValidateRates(c.ListRateLimits); err != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "configuring list rate limits: %s", err)
+	}
+
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.listRateLimits = c.ListRateLimits
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+// LimitListResults returns an iterator over results that stops once limit resources have been returned.
+// It is applied to the results of ListInAllRegions or ListInAccounts so that a list request's limit applies
+// to all of the Regions or accounts listed together, rather than to each of them.
+// Results with only diagnostics, such as the warnings for accounts that couldn't be listed, aren't counted.
+// A limit of 0 returns all results.
+func LimitListResults(results iter.Seq[list.ListResult], limit int64) iter.Seq[list.ListResult] {
+	if limit <= 0 {
+		return results
+	}
+
+	return func(yield func(list.ListResult) bool) {
+		var n int64
+		for result := range results {
+			if !yield(result) {
+				return
+			}
+
+			if !isDiagnosticsOnlyListResult(result) {
+				n++
+			}
+			if n >= limit {
+				return
+			}
+		}
+	}
+}
+
+func isDiagnosticsOnlyListResult(result list.ListResult) bool {
+	return result.Identity == nil && result.Resource == nil && result.DisplayName == ""
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"fmt"
+	"iter"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func TestLimitListResults(t *testing.T) {
+	t.Parallel()
+
+	// Two accounts of three results each, the first of which couldn't be listed in part.
+	listFunc := func(accountID string) iter.Seq[list.ListResult] {
+		return func(yield func(list.ListResult) bool) {
+			for i := range 3 {
+				if !yield(list.ListResult{DisplayName: fmt.Sprintf("%d", i)}) {
+					return
+				}
+			}
+			if accountID == "111111111111" {
+				var result list.ListResult
+				result.Diagnostics.AddError("Error Listing", "access denied")
+				yield(result)
+			}
+		}
+	}
+	results := listInAccounts([]string{"111111111111", "222222222222"}, listFunc)
+
+	testCases := map[string]struct {
+		limit int64
+		want  []string
+	}{
+		"no limit": {
+			want: []string{"111111111111: 0", "111111111111: 1", "111111111111: 2", "", "222222222222: 0", "222222222222: 1", "222222222222: 2"},
+		},
+		"within first account": {
+			limit: 2,
+			want:  []string{"111111111111: 0", "111111111111: 1"},
+		},
+		"across accounts": {
+			limit: 4,
+			want:  []string{"111111111111: 0", "111111111111: 1", "111111111111: 2", "", "222222222222: 0"},
+		},
+		"more than all": {
+			limit: 10,
+			want:  []string{"111111111111: 0", "111111111111: 1", "111111111111: 2", "", "222222222222: 0", "222222222222: 1", "222222222222: 2"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for result := range LimitListResults(results, testCase.limit) {
+				got = append(got, result.DisplayName)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -5,7 +5,10 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"slices"
+	"strings"
+	"text/template"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -139,6 +142,53 @@ func (l *ListResourceWithSDKv2Resource) ResourceData() *schema.ResourceData {
 	return l.resourceSchema.Data(&terraform.InstanceState{})
 }
 
+// DisplayName returns the display name of a listed resource in the specified Region:
+// tmpl executed against the resource's attributes, along with its Region as "region", or defaultName if tmpl is nil.
+func (l *ListResourceWithSDKv2Resource) DisplayName(tmpl *template.Template, rd *schema.ResourceData, region, defaultName string) (string, error) {
+	if tmpl == nil {
+		return defaultName, nil
+	}
+
+	data := l.attributes(rd)
+	data[names.AttrRegion] = region
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("executing display name template: %w", err)
+	}
+
+	return sb.String(), nil
+}
+
+// attributes returns the values of a listed resource's attributes, keyed by name, with sets, including nested sets, as lists.
+func (l *ListResourceWithSDKv2Resource) attributes(rd *schema.ResourceData) map[string]any {
+	attributes := make(map[string]any, len(l.resourceSchema.SchemaMap())+1)
+	for k := range l.resourceSchema.SchemaMap() {
+		attributes[k] = attributeValue(rd.Get(k))
+	}
+
+	return attributes
+}
+
+func attributeValue(v any) any {
+	switch v := v.(type) {
+	case *schema.Set:
+		return attributeValue(v.List())
+	case []any:
+		for i, e := range v {
+			v[i] = attributeValue(e)
+		}
+		return v
+	case map[string]any:
+		for k, e := range v {
+			v[k] = attributeValue(e)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
 func (l *ListResourceWithSDKv2Resource) setResourceIdentity(ctx context.Context, client *conns.AWSClient, d *schema.ResourceData) error {
 	identity, err := d.Identity()
 	if err != nil {
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// goTemplateValidator validates that a string Attribute's value is a valid Go template.
+type goTemplateValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator goTemplateValidator) Description(_ context.Context) string {
+	return "value must be a valid Go template"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator goTemplateValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator goTemplateValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	configValue := request.ConfigValue
+
+	if configValue.IsNull() || configValue.IsUnknown() {
+		return
+	}
+
+	// https://pkg.go.dev/text/template.
+	if valueString := configValue.ValueString(); !isGoTemplate(valueString) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			valueString,
+		))
+		return
+	}
+}
+
+func isGoTemplate(s string) bool {
+	_, err := template.New("").Parse(s)
+	return err == nil
+}
+
+// GoTemplate returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which can be parsed as a Go text/template.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func GoTemplate() validator.String {
+	return goTemplateValidator{}
+}
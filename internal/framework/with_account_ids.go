@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+// WithAccountIDsModel is embedded in the query model of list resources that can list in other accounts by assuming a role in each.
+// The list resource's config schema must include AccountIDsAttribute as "account_ids" and AssumeRoleNameAttribute as "assume_role_name".
+type WithAccountIDsModel struct {
+	AccountIDs     types.List   `tfsdk:"account_ids"`
+	AssumeRoleName types.String `tfsdk:"assume_role_name"`
+}
+
+// AccountIDsAttribute returns the schema of the account_ids list resource query field.
+func AccountIDsAttribute() listschema.ListAttribute {
+	return listschema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "IDs of the accounts in which to list resources, assuming the `assume_role_name` role in each, rather than the provider's account.",
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(fwvalidators.AWSAccountID()),
+			listvalidator.AlsoRequires(path.MatchRoot("assume_role_name")),
+		},
+	}
+}
+
+// AssumeRoleNameAttribute returns the schema of the assume_role_name list resource query field.
+func AssumeRoleNameAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: "Name of the IAM role to assume in each of `account_ids`.",
+	}
+}
+
+// AccountIDsValue returns the configured account IDs, in order, or nil if account_ids is not set.
+func (m WithAccountIDsModel) AccountIDsValue(ctx context.Context) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.AccountIDs.IsNull() || m.AccountIDs.IsUnknown() {
+		return nil, diags
+	}
+
+	var accountIDs []string
+	diags.Append(m.AccountIDs.ElementsAs(ctx, &accountIDs, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return accountIDs, diags
+}
+
+// ListInAccounts returns an iterator over the results of listFunc in each of the specified accounts, one account after another.
+// listFunc is called with a client for the account, which uses the credentials of the role with the specified name in the account,
+// assumed with c's credentials, and each result's DisplayName is prefixed with the account ID.
+// An error listing in one account is returned as a warning, and the remaining accounts are still listed.
+func ListInAccounts(ctx context.Context, c *conns.AWSClient, accountIDs []string, roleName string, listFunc func(context.Context, *conns.AWSClient) iter.Seq[list.ListResult]) iter.Seq[list.ListResult] {
+	return listInAccounts(accountIDs, func(accountID string) iter.Seq[list.ListResult] {
+		return listFunc(ctx, c.WithAssumedRole(ctx, accountID, roleName))
+	})
+}
+
+func listInAccounts(accountIDs []string, listFunc func(string) iter.Seq[list.ListResult]) iter.Seq[list.ListResult] {
+	return func(yield func(list.ListResult) bool) {
+		for _, accountID := range accountIDs {
+			for result := range listFunc(accountID) {
+				if result.Diagnostics.HasError() {
+					if !yield(accountErrorResult(accountID, result.Diagnostics)) {
+						return
+					}
+					break
+				}
+
+				result.DisplayName = fmt.Sprintf("%s: %s", accountID, result.DisplayName)
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// accountErrorResult returns a result with only diagnostics, in which the specified diagnostics from listing in an account are downgraded to warnings.
+func accountErrorResult(accountID string, diags diag.Diagnostics) list.ListResult {
+	var result list.ListResult
+
+	for _, d := range diags {
+		result.Diagnostics.AddWarning(
+			d.Summary(),
+			fmt.Sprintf("Listing in account %s: %s", accountID, d.Detail()),
+		)
+	}
+
+	return result
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWithAccountIDsModelAccountIDsValue(t *testing.T) {
+	t.Parallel()
+
+	m := WithAccountIDsModel{
+		AccountIDs: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("222222222222"),
+			types.StringValue("111111111111"),
+		}),
+	}
+
+	got, diags := m.AccountIDsValue(t.Context())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if diff := cmp.Diff(got, []string{"222222222222", "111111111111"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	got, diags = WithAccountIDsModel{AccountIDs: types.ListNull(types.StringType)}.AccountIDsValue(t.Context())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got != nil {
+		t.Errorf("expected no account IDs, got %v", got)
+	}
+}
+
+func TestListInAccounts(t *testing.T) {
+	t.Parallel()
+
+	listFunc := func(accountID string) iter.Seq[list.ListResult] {
+		return func(yield func(list.ListResult) bool) {
+			if !yield(list.ListResult{DisplayName: "one"}) {
+				return
+			}
+
+			// Listing fails part way through the second account.
+			if accountID == "222222222222" {
+				var result list.ListResult
+				result.Diagnostics.AddError("Error Listing", "access denied")
+				yield(result)
+				return
+			}
+
+			yield(list.ListResult{DisplayName: "two"})
+		}
+	}
+
+	var displayNames []string
+	var warnings diag.Diagnostics
+	for result := range listInAccounts([]string{"111111111111", "222222222222", "333333333333"}, listFunc) {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", result.Diagnostics)
+		}
+		if result.DisplayName == "" {
+			warnings.Append(result.Diagnostics...)
+			continue
+		}
+		displayNames = append(displayNames, result.DisplayName)
+	}
+
+	wantDisplayNames := []string{
+		"111111111111: one",
+		"111111111111: two",
+		"222222222222: one",
+		"333333333333: one",
+		"333333333333: two",
+	}
+	if diff := cmp.Diff(displayNames, wantDisplayNames); diff != "" {
+		t.Errorf("unexpected display names diff (+wanted, -got): %s", diff)
+	}
+
+	wantWarnings := diag.Diagnostics{
+		diag.NewWarningDiagnostic("Error Listing", "Listing in account 222222222222: access denied"),
+	}
+	if diff := cmp.Diff(warnings, wantWarnings); diff != "" {
+		t.Errorf("unexpected warnings diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+// WithAllRegionsModel is embedded in the query model of regional list resources that can list in all Regions.
+// The list resource's config schema must include AllRegionsAttribute as "all_regions".
+type WithAllRegionsModel struct {
+	AllRegions types.Bool `tfsdk:"all_regions"`
+}
+
+// AllRegionsAttribute returns the schema of the all_regions list resource query field.
+func AllRegionsAttribute() listschema.BoolAttribute {
+	return listschema.BoolAttribute{
+		Optional:    true,
+		Description: "Whether to list resources in all Regions enabled for the account, rather than only in `region`.",
+	}
+}
+
+// ListInAllRegions returns an iterator over the results of listFunc in each Region enabled for the account, one Region after another.
+// listFunc is called with a context whose per-resource Region override is the Region being listed,
+// and each result's DisplayName is prefixed with that Region.
+// An error listing in one Region, for example an opt-in Region that isn't enabled, is returned as a warning, and the remaining Regions are still listed.
+func ListInAllRegions(ctx context.Context, c *conns.AWSClient, listFunc func(context.Context) iter.Seq[list.ListResult]) iter.Seq[list.ListResult] {
+	return func(yield func(list.ListResult) bool) {
+		regions, err := enabledRegions(ctx, c)
+		if err != nil {
+			yield(fwdiag.NewListResultErrorDiagnostic(err))
+			return
+		}
+
+		for result := range listInRegions(regions, func(region string) iter.Seq[list.ListResult] {
+			return listFunc(WithOverrideRegion(ctx, region))
+		}) {
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listInRegions(regions []string, listFunc func(string) iter.Seq[list.ListResult]) iter.Seq[list.ListResult] {
+	return func(yield func(list.ListResult) bool) {
+		for _, region := range regions {
+			for result := range listFunc(region) {
+				if result.Diagnostics.HasError() {
+					if !yield(regionErrorResult(region, result.Diagnostics)) {
+						return
+					}
+					break
+				}
+
+				if result.DisplayName != "" {
+					result.DisplayName = fmt.Sprintf("%s: %s", region, result.DisplayName)
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// regionErrorResult returns a result with only diagnostics, in which the specified diagnostics from listing in a Region are downgraded to warnings.
+func regionErrorResult(region string, diags diag.Diagnostics) list.ListResult {
+	var result list.ListResult
+
+	for _, d := range diags {
+		result.Diagnostics.AddWarning(
+			d.Summary(),
+			fmt.Sprintf("%s: %s", region, d.Detail()),
+		)
+	}
+
+	return result
+}
+
+// enabledRegions returns the names of the Regions enabled for the account, sorted.
+func enabledRegions(ctx context.Context, c *conns.AWSClient) ([]string, error) {
+	var input ec2.DescribeRegionsInput
+	output, err := c.EC2Client(ctx).DescribeRegions(ctx, &input)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Regions: %w", err)
+	}
+
+	regions := make([]string, 0, len(output.Regions))
+	for _, v := range output.Regions {
+		regions = append(regions, aws.ToString(v.RegionName))
+	}
+	slices.Sort(regions)
+
+	return regions, nil
+}
+
+// WithOverrideRegion returns a copy of ctx whose per-resource Region override is the specified Region.
+func WithOverrideRegion(ctx context.Context, region string) context.Context {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return conns.NewResourceContext(ctx, "", "", "", region)
+	}
+
+	return conns.NewResourceContext(ctx, inContext.ServicePackageName(), inContext.ResourceName(), inContext.TypeName(), region)
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func TestListInRegions(t *testing.T) {
+	t.Parallel()
+
+	listFunc := func(region string) iter.Seq[list.ListResult] {
+		return func(yield func(list.ListResult) bool) {
+			// Listing fails in an opt-in Region that isn't enabled.
+			if region == "me-south-1" {
+				var result list.ListResult
+				result.Diagnostics.AddError("Error Listing", "UnrecognizedClientException: The security token included in the request is invalid")
+				yield(result)
+				return
+			}
+
+			if !yield(list.ListResult{DisplayName: "one"}) {
+				return
+			}
+
+			yield(list.ListResult{DisplayName: "two"})
+		}
+	}
+
+	var displayNames []string
+	var warnings diag.Diagnostics
+	for result := range listInRegions([]string{"eu-west-1", "me-south-1", "us-west-2"}, listFunc) {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", result.Diagnostics)
+		}
+		if result.DisplayName == "" {
+			warnings.Append(result.Diagnostics...)
+			continue
+		}
+		displayNames = append(displayNames, result.DisplayName)
+	}
+
+	wantDisplayNames := []string{
+		"eu-west-1: one",
+		"eu-west-1: two",
+		"us-west-2: one",
+		"us-west-2: two",
+	}
+	if diff := cmp.Diff(displayNames, wantDisplayNames); diff != "" {
+		t.Errorf("unexpected display names diff (+wanted, -got): %s", diff)
+	}
+
+	wantWarnings := diag.Diagnostics{
+		diag.NewWarningDiagnostic("Error Listing", "me-south-1: UnrecognizedClientException: The security token included in the request is invalid"),
+	}
+	if diff := cmp.Diff(warnings, wantWarnings); diff != "" {
+		t.Errorf("unexpected warnings diff (+wanted, -got): %s", diff)
+	}
+}
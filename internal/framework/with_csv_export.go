@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+// csvExportHeader is the header row of a CSV export.
+var csvExportHeader = []string{"id", "display_name", "region", "tags"}
+
+// WithCSVExportModel is embedded in the query model of list resources whose results can be exported to a CSV file.
+// The list resource's config schema must include CSVExportPathAttribute as "csv_export_path".
+type WithCSVExportModel struct {
+	CSVExportPath types.String `tfsdk:"csv_export_path"`
+}
+
+// CSVExportPathAttribute returns the schema of the csv_export_path list resource query field.
+func CSVExportPathAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: "Path of a CSV file to which each listed resource is written as a row. The file is overwritten.",
+	}
+}
+
+// OpenCSVExport creates the csv_export_path file and writes its header row, or returns nil if csv_export_path is not set.
+// The file must be closed with Close, or by iterating over the results returned by CloseAfter.
+func (m WithCSVExportModel) OpenCSVExport() (*CSVExport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.CSVExportPath.IsNull() || m.CSVExportPath.IsUnknown() {
+		return nil, diags
+	}
+
+	file, err := os.Create(m.CSVExportPath.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("csv_export_path"),
+			"Invalid CSV Export Path",
+			"The CSV export file cannot be created.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	e := &CSVExport{
+		file: file,
+		w:    csv.NewWriter(file),
+	}
+	if err := e.w.Write(csvExportHeader); err != nil {
+		e.err = fmt.Errorf("writing CSV export header: %w", err)
+	}
+
+	return e, diags
+}
+
+// CSVExport writes listed resources to a file as CSV, one row per resource.
+// Tags are packed into a single column as "key=value;" pairs, ordered by key.
+// A nil *CSVExport exports nothing.
+type CSVExport struct {
+	mutex sync.Mutex
+	file  *os.File
+	w     *csv.Writer
+	err   error // The first error writing a row.
+}
+
+// Add writes a row for the specified record.
+// Errors are returned by Close.
+func (e *CSVExport) Add(record ExportRecord) {
+	if e == nil {
+		return
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.err != nil {
+		return
+	}
+
+	if err := e.w.Write([]string{record.ID, record.DisplayName, record.Region, csvExportTags(record.Tags)}); err != nil {
+		e.err = fmt.Errorf("writing CSV export row (%s): %w", record.ID, err)
+	}
+}
+
+// Close flushes and closes the file, returning the first error writing to it.
+func (e *CSVExport) Close() error {
+	if e == nil {
+		return nil
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	err := e.err
+	if e.err == nil {
+		e.w.Flush()
+		err = e.w.Error()
+	}
+
+	return errors.Join(err, e.file.Close())
+}
+
+// CloseAfter returns an iterator over results that closes the export once results are exhausted, or iteration stops.
+// If the file can't be written, an error diagnostic is returned as a final result.
+func (e *CSVExport) CloseAfter(results iter.Seq[list.ListResult]) iter.Seq[list.ListResult] {
+	if e == nil {
+		return results
+	}
+
+	return func(yield func(list.ListResult) bool) {
+		more := true
+		for result := range results {
+			if more = yield(result); !more {
+				break
+			}
+		}
+
+		if err := e.Close(); err != nil && more {
+			yield(fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("exporting listed resources to CSV: %w", err)))
+		}
+	}
+}
+
+// csvExportTags returns tags as "key=value;" pairs, ordered by key.
+func csvExportTags(tags map[string]string) string {
+	var sb strings.Builder
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		sb.WriteString(tags[k])
+		sb.WriteByte(';')
+	}
+
+	return sb.String()
+}
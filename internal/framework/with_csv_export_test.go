@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCSVExport(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "export.csv")
+	m := WithCSVExportModel{
+		CSVExportPath: types.StringValue(path),
+	}
+
+	e, diags := m.OpenCSVExport()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	e.Add(ExportRecord{
+		ID:          "example",
+		DisplayName: "Example, Inc.",
+		Region:      "us-west-2", //lintignore:AWSAT003
+		Tags: map[string]string{
+			"Name":        "example",
+			"Environment": "test",
+		},
+	})
+	e.Add(ExportRecord{
+		ID:          "untagged",
+		DisplayName: "untagged",
+		Region:      "us-west-2", //lintignore:AWSAT003
+	})
+
+	results := e.CloseAfter(func(yield func(list.ListResult) bool) {
+		yield(list.ListResult{DisplayName: "example"})
+	})
+	var n int
+	for range results {
+		n++
+	}
+	if n != 1 {
+		t.Errorf("expected 1 result, got %d", n)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading CSV export: %s", err)
+	}
+
+	want := `id,display_name,region,tags
+example,"Example, Inc.",us-west-2,Environment=test;Name=example;
+untagged,untagged,us-west-2,
+`
+	if diff := cmp.Diff(string(got), want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestCSVExport_disabled(t *testing.T) {
+	t.Parallel()
+
+	var m WithCSVExportModel
+	e, diags := m.OpenCSVExport()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if e != nil {
+		t.Fatalf("expected no CSV export")
+	}
+
+	// A nil *CSVExport is a no-op.
+	e.Add(ExportRecord{ID: "example"})
+	if err := e.Close(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestWithCSVExportModelOpenCSVExport_invalidPath(t *testing.T) {
+	t.Parallel()
+
+	m := WithCSVExportModel{
+		CSVExportPath: types.StringValue(filepath.Join(t.TempDir(), "missing", "export.csv")),
+	}
+
+	if _, diags := m.OpenCSVExport(); !diags.HasError() {
+		t.Error("expected error, got none")
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+// WithDisplayNameTemplateModel is embedded in the query model of list resources whose results' display names can be customized.
+// The list resource's config schema must include DisplayNameTemplateAttribute as "display_name_template".
+type WithDisplayNameTemplateModel struct {
+	DisplayNameTemplate types.String `tfsdk:"display_name_template"`
+}
+
+// DisplayNameTemplateAttribute returns the schema of the display_name_template list resource query field.
+func DisplayNameTemplateAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: "Go template for the display name of each listed resource, executed against the resource's attributes, for example `{{.name}} ({{.region}})`.",
+		Validators: []validator.String{
+			fwvalidators.GoTemplate(),
+		},
+	}
+}
+
+// ParseDisplayNameTemplate returns the parsed display_name_template, or nil if display_name_template is not set.
+// Referencing an attribute that the resource doesn't have is an error when the template is executed.
+func (m WithDisplayNameTemplateModel) ParseDisplayNameTemplate() (*template.Template, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.DisplayNameTemplate.IsNull() || m.DisplayNameTemplate.IsUnknown() {
+		return nil, diags
+	}
+
+	tmpl, err := template.New("display_name_template").Option("missingkey=error").Parse(m.DisplayNameTemplate.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("display_name_template"),
+			"Invalid Go Template Value",
+			"The provided value cannot be parsed as a Go template.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	return tmpl, diags
+}
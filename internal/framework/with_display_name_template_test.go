@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestListResourceWithSDKv2ResourceDisplayName(t *testing.T) {
+	t.Parallel()
+
+	var l ListResourceWithSDKv2Resource
+	l.SetResourceSchema(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"retention_in_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	})
+
+	testCases := map[string]struct {
+		displayNameTemplate types.String
+		expected            string
+		expectError         bool
+	}{
+		"null": {
+			displayNameTemplate: types.StringNull(),
+			expected:            "default",
+		},
+		"attributes": {
+			displayNameTemplate: types.StringValue("{{.name}} ({{.region}}, {{.retention_in_days}} days)"),
+			expected:            "example (us-west-2, 30 days)", //lintignore:AWSAT003
+		},
+		"missing attribute": {
+			displayNameTemplate: types.StringValue("{{.nmae}}"),
+			expectError:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := WithDisplayNameTemplateModel{
+				DisplayNameTemplate: testCase.displayNameTemplate,
+			}
+			tmpl, diags := m.ParseDisplayNameTemplate()
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			rd := l.ResourceData()
+			rd.Set("name", "example")
+			rd.Set("retention_in_days", 30)
+
+			got, err := l.DisplayName(tmpl, rd, "us-west-2", "default") //lintignore:AWSAT003
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestWithDisplayNameTemplateModelParseDisplayNameTemplate_invalid(t *testing.T) {
+	t.Parallel()
+
+	m := WithDisplayNameTemplateModel{
+		DisplayNameTemplate: types.StringValue("{{.name"),
+	}
+	if _, diags := m.ParseDisplayNameTemplate(); !diags.HasError() {
+		t.Error("expected error, got none")
+	}
+}
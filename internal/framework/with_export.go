@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// WithExportModel is embedded in the query model of list resources whose results can be exported to a file.
+// The list resource's config schema must include ExportPathAttribute as "export_path".
+type WithExportModel struct {
+	ExportPath types.String `tfsdk:"export_path"`
+}
+
+// ExportPathAttribute returns the schema of the export_path list resource query field.
+func ExportPathAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: "Path of a file to which each listed resource is written as a line of JSON. The file is overwritten.",
+	}
+}
+
+// OpenExport creates the export_path file, or returns nil if export_path is not set.
+// The file must be closed with Close, or by iterating over the results returned by CloseAfter.
+func (m WithExportModel) OpenExport() (*Export, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.ExportPath.IsNull() || m.ExportPath.IsUnknown() {
+		return nil, diags
+	}
+
+	file, err := os.Create(m.ExportPath.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("export_path"),
+			"Invalid Export Path",
+			"The export file cannot be created.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	w := bufio.NewWriter(file)
+
+	return &Export{
+		file:    file,
+		w:       w,
+		encoder: json.NewEncoder(w),
+	}, diags
+}
+
+// ExportRecord is a listed resource, as exported.
+type ExportRecord struct {
+	ID          string            `json:"id"`
+	DisplayName string            `json:"display_name"`
+	Region      string            `json:"region"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Attributes  map[string]any    `json:"attributes"`
+}
+
+// Export writes listed resources to a file as newline-delimited JSON.
+// A nil *Export exports nothing.
+type Export struct {
+	mutex   sync.Mutex
+	file    *os.File
+	w       *bufio.Writer
+	encoder *json.Encoder
+	err     error // The first error writing a record.
+}
+
+// Add writes the specified record.
+// Errors are returned by Close.
+func (e *Export) Add(record ExportRecord) {
+	if e == nil {
+		return
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.err != nil {
+		return
+	}
+
+	if err := e.encoder.Encode(record); err != nil {
+		e.err = fmt.Errorf("writing export record (%s): %w", record.ID, err)
+	}
+}
+
+// Close flushes and closes the file, returning the first error writing to it.
+func (e *Export) Close() error {
+	if e == nil {
+		return nil
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	err := e.err
+	if e.err == nil {
+		err = e.w.Flush()
+	}
+
+	return errors.Join(err, e.file.Close())
+}
+
+// CloseAfter returns an iterator over results that closes the export once results are exhausted, or iteration stops.
+// If the file can't be written, an error diagnostic is returned as a final result.
+func (e *Export) CloseAfter(results iter.Seq[list.ListResult]) iter.Seq[list.ListResult] {
+	if e == nil {
+		return results
+	}
+
+	return func(yield func(list.ListResult) bool) {
+		more := true
+		for result := range results {
+			if more = yield(result); !more {
+				break
+			}
+		}
+
+		if err := e.Close(); err != nil && more {
+			yield(fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("exporting listed resources: %w", err)))
+		}
+	}
+}
+
+// ExportRecord returns the export record of a listed resource in the specified Region.
+func (l *ListResourceWithSDKv2Resource) ExportRecord(rd *schema.ResourceData, region, displayName string) ExportRecord {
+	attributes := l.attributes(rd)
+
+	var tags map[string]string
+	if v, ok := attributes[names.AttrTags].(map[string]any); ok && len(v) > 0 {
+		tags = make(map[string]string, len(v))
+		for k, v := range v {
+			tags[k], _ = v.(string)
+		}
+	}
+
+	return ExportRecord{
+		ID:          rd.Id(),
+		DisplayName: displayName,
+		Region:      region,
+		Tags:        tags,
+		Attributes:  attributes,
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	var l ListResourceWithSDKv2Resource
+	l.SetResourceSchema(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"aliases": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	})
+
+	path := filepath.Join(t.TempDir(), "export.jsonl")
+	m := WithExportModel{
+		ExportPath: types.StringValue(path),
+	}
+
+	e, diags := m.OpenExport()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	rd := l.ResourceData()
+	rd.SetId("example")
+	rd.Set("name", "example")
+	rd.Set("aliases", []any{"alias"})
+	rd.Set("tags", map[string]any{"Environment": "test"})
+	e.Add(l.ExportRecord(rd, "us-west-2", "Example")) //lintignore:AWSAT003
+
+	rd = l.ResourceData()
+	rd.SetId("untagged")
+	rd.Set("name", "untagged")
+	e.Add(l.ExportRecord(rd, "us-west-2", "untagged")) //lintignore:AWSAT003
+
+	results := e.CloseAfter(func(yield func(list.ListResult) bool) {
+		yield(list.ListResult{DisplayName: "example"})
+	})
+	var n int
+	for range results {
+		n++
+	}
+	if n != 1 {
+		t.Errorf("expected 1 result, got %d", n)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading export: %s", err)
+	}
+
+	want := `{"id":"example","display_name":"Example","region":"us-west-2","tags":{"Environment":"test"},"attributes":{"aliases":["alias"],"name":"example","tags":{"Environment":"test"}}}
+{"id":"untagged","display_name":"untagged","region":"us-west-2","attributes":{"aliases":[],"name":"untagged","tags":{}}}
+`
+	if diff := cmp.Diff(string(got), want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestExport_disabled(t *testing.T) {
+	t.Parallel()
+
+	var m WithExportModel
+	e, diags := m.OpenExport()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if e != nil {
+		t.Fatalf("expected no export")
+	}
+
+	// A nil *Export is a no-op.
+	e.Add(ExportRecord{ID: "example"})
+	if err := e.Close(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestWithExportModelOpenExport_invalidPath(t *testing.T) {
+	t.Parallel()
+
+	m := WithExportModel{
+		ExportPath: types.StringValue(filepath.Join(t.TempDir(), "missing", "export.jsonl")),
+	}
+
+	if _, diags := m.OpenExport(); !diags.HasError() {
+		t.Error("expected error, got none")
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"fmt"
+	"iter"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+// WithImportBlocksModel is embedded in the query model of list resources that can generate import blocks for their results.
+// The list resource's config schema must include GenerateImportBlocksAttribute as "generate_import_blocks"
+// and ImportBlocksPathAttribute as "import_blocks_path".
+type WithImportBlocksModel struct {
+	GenerateImportBlocks types.Bool   `tfsdk:"generate_import_blocks"`
+	ImportBlocksPath     types.String `tfsdk:"import_blocks_path"`
+}
+
+// GenerateImportBlocksAttribute returns the schema of the generate_import_blocks list resource query field.
+func GenerateImportBlocksAttribute() listschema.BoolAttribute {
+	return listschema.BoolAttribute{
+		Optional:    true,
+		Description: "Whether to write an `import` block for each listed resource to `import_blocks_path`.",
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("import_blocks_path")),
+		},
+	}
+}
+
+// ImportBlocksPathAttribute returns the schema of the import_blocks_path list resource query field.
+func ImportBlocksPathAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: "Path of the file to which `import` blocks are written when `generate_import_blocks` is `true`. The file is overwritten.",
+	}
+}
+
+// NewImportBlocks returns the import blocks to generate for resources of the specified type,
+// or nil if generate_import_blocks is not true.
+func (m WithImportBlocksModel) NewImportBlocks(resourceType string) *ImportBlocks {
+	if !m.GenerateImportBlocks.ValueBool() {
+		return nil
+	}
+
+	return &ImportBlocks{
+		path:         m.ImportBlocksPath.ValueString(),
+		resourceType: resourceType,
+		names:        make(map[string]int),
+	}
+}
+
+// ImportBlocks accumulates an import block for each listed resource, and writes them to a file once listing finishes.
+// A nil *ImportBlocks generates nothing.
+type ImportBlocks struct {
+	mutex        sync.Mutex
+	path         string
+	resourceType string
+	blocks       strings.Builder
+	names        map[string]int // Number of blocks generated for each resource name, so that addresses are unique.
+}
+
+// Add adds an import block for the listed resource with the specified name, import ID and Region.
+// The resource's address is derived from its name, and the ID is suffixed with "@<region>" so that it is imported in the Region in which it was listed.
+func (b *ImportBlocks) Add(name, id, region string) {
+	if b == nil {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	name = importBlockResourceName(name)
+	b.names[name]++
+	if n := b.names[name]; n > 1 {
+		name = fmt.Sprintf("%s_%d", name, n)
+	}
+
+	if region != "" {
+		id = id + "@" + region
+	}
+
+	fmt.Fprintf(&b.blocks, "import {\n  to = %s.%s\n  id = %s\n}\n\n", b.resourceType, name, importBlockString(id))
+}
+
+// Write writes the import blocks added so far to the file.
+func (b *ImportBlocks) Write() error {
+	if b == nil {
+		return nil
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if err := os.WriteFile(b.path, []byte(b.blocks.String()), 0o644); err != nil { //nolint:gosec // Import blocks are configuration, not secrets.
+		return fmt.Errorf("writing import blocks: %w", err)
+	}
+
+	return nil
+}
+
+// WriteAfter returns an iterator over results that writes the import blocks once results are exhausted, or iteration stops.
+// If the file can't be written, an error diagnostic is returned as a final result.
+func (b *ImportBlocks) WriteAfter(results iter.Seq[list.ListResult]) iter.Seq[list.ListResult] {
+	if b == nil {
+		return results
+	}
+
+	return func(yield func(list.ListResult) bool) {
+		more := true
+		for result := range results {
+			if more = yield(result); !more {
+				break
+			}
+		}
+
+		if err := b.Write(); err != nil && more {
+			yield(fwdiag.NewListResultErrorDiagnostic(err))
+		}
+	}
+}
+
+// importBlockResourceName returns a valid Terraform resource name derived from s.
+// Characters other than letters, digits, underscores and hyphens are replaced with underscores,
+// and names that don't start with a letter or underscore are prefixed with one.
+func importBlockResourceName(s string) string {
+	name := []rune(s)
+	for i, r := range name {
+		if !isImportBlockResourceNameRune(r) {
+			name[i] = '_'
+		}
+	}
+
+	if len(name) == 0 || !(name[0] == '_' || (name[0] >= 'a' && name[0] <= 'z') || (name[0] >= 'A' && name[0] <= 'Z')) {
+		name = append([]rune{'_'}, name...)
+	}
+
+	return string(name)
+}
+
+func isImportBlockResourceNameRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '-'
+}
+
+// importBlockString returns s as a quoted HCL string, with template sequences escaped.
+func importBlockString(s string) string {
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(strconv.Quote(s))
+}
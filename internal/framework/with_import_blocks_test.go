@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestImportBlocks(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "imports.tf")
+	m := WithImportBlocksModel{
+		GenerateImportBlocks: types.BoolValue(true),
+		ImportBlocksPath:     types.StringValue(path),
+	}
+
+	b := m.NewImportBlocks("aws_cloudwatch_log_group")
+	b.Add("/aws/lambda/example", "/aws/lambda/example", "us-west-2") //lintignore:AWSAT003
+	b.Add("/aws/lambda/example", "/aws/lambda/example", "us-east-1") //lintignore:AWSAT003
+	b.Add("1-example", "${example}", "")
+
+	results := b.WriteAfter(func(yield func(list.ListResult) bool) {
+		yield(list.ListResult{DisplayName: "example"})
+	})
+	var n int
+	for range results {
+		n++
+	}
+	if n != 1 {
+		t.Errorf("expected 1 result, got %d", n)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading import blocks: %s", err)
+	}
+
+	want := `import {
+  to = aws_cloudwatch_log_group._aws_lambda_example
+  id = "/aws/lambda/example@us-west-2"
+}
+
+import {
+  to = aws_cloudwatch_log_group._aws_lambda_example_2
+  id = "/aws/lambda/example@us-east-1"
+}
+
+import {
+  to = aws_cloudwatch_log_group._1-example
+  id = "$${example}"
+}
+
+`
+	if diff := cmp.Diff(string(got), want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestImportBlocks_disabled(t *testing.T) {
+	t.Parallel()
+
+	var m WithImportBlocksModel
+	b := m.NewImportBlocks("aws_s3_bucket")
+	if b != nil {
+		t.Fatalf("expected no import blocks")
+	}
+
+	// A nil *ImportBlocks is a no-op.
+	b.Add("example", "example", "us-west-2") //lintignore:AWSAT003
+	if err := b.Write(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestImportBlocksWriteAfter_error(t *testing.T) {
+	t.Parallel()
+
+	m := WithImportBlocksModel{
+		GenerateImportBlocks: types.BoolValue(true),
+		ImportBlocksPath:     types.StringValue(filepath.Join(t.TempDir(), "missing", "imports.tf")),
+	}
+
+	var got []list.ListResult
+	for result := range m.NewImportBlocks("aws_s3_bucket").WriteAfter(func(yield func(list.ListResult) bool) {}) {
+		got = append(got, result)
+	}
+
+	if len(got) != 1 || !got[0].Diagnostics.HasError() {
+		t.Errorf("expected an error diagnostic, got %v", got)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// WithNameRegexModel is embedded in the query model of list resources that can be filtered by name.
+// The list resource's config schema must include NameRegexAttribute as "name_regex".
+type WithNameRegexModel struct {
+	NameRegex fwtypes.Regexp `tfsdk:"name_regex"`
+}
+
+// NameRegexAttribute returns the schema of the name_regex list resource query field.
+func NameRegexAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		CustomType:  fwtypes.RegexpType,
+		Optional:    true,
+		Description: "Regular expression that the names of listed resources must match.",
+	}
+}
+
+// NamePredicate returns a predicate that is true for names matching name_regex, or for all names if name_regex is not set.
+// Names are filtered client-side, after resources are listed.
+func (m WithNameRegexModel) NamePredicate() (tfslices.Predicate[string], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.NameRegex.IsNull() || m.NameRegex.IsUnknown() {
+		return tfslices.PredicateTrue[string](), diags
+	}
+
+	re := m.NameRegex.ValueRegexp()
+	if re == nil {
+		diags.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regexp Value",
+			"The provided value cannot be parsed as a regular expression.\n\n"+
+				"Value: "+m.NameRegex.ValueString(),
+		)
+		return nil, diags
+	}
+
+	return re.MatchString, diags
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"testing"
+
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestWithNameRegexModelNamePredicate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		nameRegex     fwtypes.Regexp
+		expectedMatch map[string]bool
+		expectError   bool
+	}{
+		"null": {
+			nameRegex: fwtypes.RegexpNull(),
+			expectedMatch: map[string]bool{
+				"":    true,
+				"abc": true,
+			},
+		},
+		"valid": {
+			nameRegex: fwtypes.RegexpValue(`^/aws/lambda/`),
+			expectedMatch: map[string]bool{
+				"/aws/lambda/fn": true,
+				"/aws/ecs/svc":   false,
+			},
+		},
+		"invalid": {
+			nameRegex:   fwtypes.RegexpValue(`(`),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := WithNameRegexModel{NameRegex: testCase.nameRegex}
+			predicate, diags := m.NamePredicate()
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Fatalf("expected error %t, got %t: %v", want, got, diags)
+			}
+
+			for v, want := range testCase.expectedMatch {
+				if got := predicate(v); got != want {
+					t.Errorf("predicate(%q) = %t, want %t", v, got, want)
+				}
+			}
+		})
+	}
+}
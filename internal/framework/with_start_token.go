@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+// WithStartTokenModel is embedded in the query model of list resources that can resume listing from a pagination token.
+// The list resource's config schema must include StartTokenAttribute as "start_token".
+type WithStartTokenModel struct {
+	StartToken types.String `tfsdk:"start_token"`
+}
+
+// StartTokenAttribute returns the schema of the start_token list resource query field.
+// start_token can't be set along with the query fields that list resources with something other than a single paginated operation.
+func StartTokenAttribute(conflictsWith ...path.Expression) listschema.StringAttribute {
+	validators := []validator.String{
+		stringvalidator.LengthAtLeast(1),
+	}
+	if len(conflictsWith) > 0 {
+		validators = append(validators, stringvalidator.ConflictsWith(conflictsWith...))
+	}
+
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: "Pagination token from which to resume a listing that failed, as reported by the failed listing. Tokens are opaque, and only valid for the same query.",
+		Validators:  validators,
+	}
+}
+
+// StartTokenError is an error listing resources after which listing can be resumed from a pagination token.
+type StartTokenError struct {
+	Err error
+	// StartToken is the pagination token with which the page that failed was requested.
+	// It is empty if the first page failed.
+	StartToken string
+}
+
+func (e *StartTokenError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StartTokenError) Unwrap() error {
+	return e.Err
+}
+
+// NewResumableListResultErrorDiagnostic returns a list result with an error diagnostic for err.
+// If err is a StartTokenError with a pagination token, a warning diagnostic reports the start_token with which listing can be resumed.
+func NewResumableListResultErrorDiagnostic(err error) list.ListResult {
+	result := fwdiag.NewListResultErrorDiagnostic(err)
+
+	if v, ok := errs.As[*StartTokenError](err); ok && v.StartToken != "" {
+		result.Diagnostics.AddAttributeWarning(
+			path.Root("start_token"),
+			"Listing Can Be Resumed",
+			fmt.Sprintf("Listing can be resumed from the page that failed by setting start_token to %q. ", v.StartToken)+
+				"The token is opaque, and is only valid for the same query.",
+		)
+	}
+
+	return result
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestNewResumableListResultErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err          error
+		wantWarnings int
+	}{
+		"plain error": {
+			err:          errors.New("listing failed"),
+			wantWarnings: 0,
+		},
+		"first page": {
+			err:          &StartTokenError{Err: errors.New("listing failed")},
+			wantWarnings: 0,
+		},
+		"later page": {
+			err:          &StartTokenError{Err: errors.New("listing failed"), StartToken: "token"},
+			wantWarnings: 1,
+		},
+		"wrapped": {
+			err:          fmt.Errorf("listing in us-west-2: %w", &StartTokenError{Err: errors.New("listing failed"), StartToken: "token"}), //lintignore:AWSAT003
+			wantWarnings: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := NewResumableListResultErrorDiagnostic(testCase.err)
+
+			if got, want := result.Diagnostics.ErrorsCount(), 1; got != want {
+				t.Errorf("expected %d errors, got %d", want, got)
+			}
+			if got, want := result.Diagnostics.WarningsCount(), testCase.wantWarnings; got != want {
+				t.Errorf("expected %d warnings, got %d", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iter
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// MappedConcurrently returns an iterator over the results of applying f to each element of the sequence.
+// f is applied to up to n elements concurrently, but results are yielded in the order of the sequence.
+// The sequence is returned by seq and consumed in a separate goroutine.
+// If iteration stops early, no more elements are consumed, the contexts passed to seq and f are cancelled,
+// and the iterator doesn't return until the sequence and all calls to f have returned.
+// Sequences that make calls with the context passed to seq so stop promptly, rather than finishing an element no longer needed.
+func MappedConcurrently[E, T any](ctx context.Context, seq func(context.Context) iter.Seq[E], n int, f func(context.Context, E) T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// One element is awaited by the consumer while up to n-1 more are in progress.
+		pending := make(chan chan T, max(n, 1)-1)
+		done := make(chan struct{})
+		var wg sync.WaitGroup
+
+		wg.Go(func() {
+			defer close(pending)
+
+			for e := range seq(ctx) {
+				// Once the consumer has stopped, the pending channel is drained and so ready to send to,
+				// so stopping is checked for first.
+				if isDone(done) {
+					return
+				}
+
+				result := make(chan T, 1)
+				select {
+				case pending <- result:
+				case <-done:
+					return
+				}
+
+				wg.Go(func() {
+					// Results are no longer yielded once the consumer has stopped, so f isn't called.
+					if isDone(done) {
+						var zero T
+						result <- zero
+						return
+					}
+					result <- f(ctx, e)
+				})
+
+				// Stopping is checked for again before the next element is consumed.
+				if isDone(done) {
+					return
+				}
+			}
+		})
+
+		defer func() {
+			cancel()
+			close(done)
+			for range pending { //nolint:revive // Drain so that the producer goroutine exits.
+			}
+			wg.Wait()
+		}()
+
+		for result := range pending {
+			if !yield(<-result) {
+				return
+			}
+		}
+	}
+}
+
+func isDone(done <-chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
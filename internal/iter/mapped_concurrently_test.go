@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iter
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMappedConcurrently(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input    []int
+		n        int
+		expected []int
+	}
+	tests := map[string]testCase{
+		"zero elements": {
+			input:    []int{},
+			n:        3,
+			expected: nil,
+		},
+		"serial": {
+			input:    []int{1, 2, 3, 4, 5},
+			n:        1,
+			expected: []int{2, 4, 6, 8, 10},
+		},
+		"concurrent": {
+			input:    []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			n:        3,
+			expected: []int{2, 4, 6, 8, 10, 12, 14, 16, 18, 20},
+		},
+		"zero concurrency": {
+			input:    []int{1, 2, 3},
+			n:        0,
+			expected: []int{2, 4, 6},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var running, maxRunning atomic.Int32
+			f := func(_ context.Context, v int) int {
+				r := running.Add(1)
+				for {
+					m := maxRunning.Load()
+					if r <= m || maxRunning.CompareAndSwap(m, r) {
+						break
+					}
+				}
+				defer running.Add(-1)
+
+				// Later elements finish first.
+				time.Sleep(time.Duration(20-v) * time.Millisecond)
+
+				return v * 2
+			}
+
+			got := slices.Collect(MappedConcurrently(t.Context(), values(test.input), test.n, f))
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, want := int(maxRunning.Load()), max(test.n, 1); got > want {
+				t.Errorf("expected at most %d concurrent calls, got %d", want, got)
+			}
+		})
+	}
+}
+
+func TestMappedConcurrently_stopEarly(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	f := func(_ context.Context, v int) int {
+		calls.Add(1)
+		return v
+	}
+
+	var got []int
+	for v := range MappedConcurrently(t.Context(), values([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}), 2, f) {
+		got = append(got, v)
+		if len(got) == 3 {
+			break
+		}
+	}
+
+	if diff := cmp.Diff(got, []int{1, 2, 3}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if n := calls.Load(); n == 10 {
+		t.Errorf("expected the sequence not to be consumed after stopping, got %d calls", n)
+	}
+}
+
+func TestMappedConcurrently_noCallsAfterStop(t *testing.T) {
+	t.Parallel()
+
+	var calls, cancelled atomic.Int32
+	f := func(ctx context.Context, v int) int {
+		calls.Add(1)
+		if v > 1 {
+			// Later elements are still in progress when iteration stops, until their context is cancelled.
+			<-ctx.Done()
+			cancelled.Add(1)
+		}
+		return v
+	}
+
+	for range MappedConcurrently(t.Context(), values([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}), 3, f) {
+		break
+	}
+
+	n := calls.Load()
+	if got, want := cancelled.Load(), n-1; got != want {
+		t.Errorf("expected %d calls to be cancelled, got %d", want, got)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	if got := calls.Load(); got != n {
+		t.Errorf("expected no calls after stopping, got %d", got-n)
+	}
+	if n > 3 {
+		t.Errorf("expected at most 3 calls, got %d", n)
+	}
+}
+
+func TestMappedConcurrently_cancelsSequence(t *testing.T) {
+	t.Parallel()
+
+	var cancelled atomic.Bool
+	seq := func(ctx context.Context) iter.Seq[int] {
+		return func(yield func(int) bool) {
+			if !yield(1) {
+				return
+			}
+
+			// The next element is still being listed when iteration stops, until the context is cancelled.
+			<-ctx.Done()
+			cancelled.Store(true)
+			yield(2)
+		}
+	}
+	f := func(_ context.Context, v int) int {
+		return v
+	}
+
+	for range MappedConcurrently(t.Context(), seq, 2, f) {
+		break
+	}
+
+	if !cancelled.Load() {
+		t.Error("expected the sequence's context to be cancelled")
+	}
+}
+
+// values returns a function returning an iterator over the elements of s, for use as MappedConcurrently's seq.
+func values[E any](s []E) func(context.Context) iter.Seq[E] {
+	return func(context.Context) iter.Seq[E] {
+		return slices.Values(s)
+	}
+}
@@ -137,6 +137,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"list_rate_limits": schema.MapAttribute{
+				ElementType: types.Float64Type,
+				Optional:    true,
+				Description: "Maximum rates, in calls per second, of AWS API operations used by list resources, keyed by operation or, for services that throttle across operations, service name. Overrides the provider's default rate limits.",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
@@ -161,6 +161,13 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 						"default value is `false`",
 				},
+				"list_rate_limits": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeFloat},
+					Description: "Maximum rates, in calls per second, of AWS API operations used by list resources, " +
+						"keyed by operation or, for services that throttle across operations, service name. Overrides the provider's default rate limits.",
+				},
 				"max_retries": {
 					Type:     schema.TypeInt,
 					Optional: true,
@@ -504,6 +511,10 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	}
 	config.TagPolicyConfig = tagCfg
 
+	if v, ok := d.GetOk("list_rate_limits"); ok && len(v.(map[string]any)) > 0 {
+		config.ListRateLimits = expandListRateLimits(v.(map[string]any))
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -1228,6 +1239,16 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
+func expandListRateLimits(tfMap map[string]any) map[string]float64 {
+	rates := make(map[string]float64, len(tfMap))
+
+	for k, v := range tfMap {
+		rates[k] = v.(float64)
+	}
+
+	return rates
+}
+
 func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// The rate is halved each time a call is throttled...
+	throttledRateFactor = 0.5
+	// ...and recovers by a twentieth of the maximum rate with each subsequent call.
+	recoveryRateFactor = 0.05
+	// The rate never falls below one call every 10 seconds.
+	minimumRate = 0.1
+)
+
+var (
+	registryLock sync.Mutex
+	registry     = make(map[string]*Limiters)
+)
+
+// Limiters holds the Limiters for a single AWS API operation, one per account, Region and rate.
+// AWS applies its quotas per account and Region, so calls to distinct endpoints are limited independently.
+type Limiters struct {
+	operation   string
+	defaultRate float64
+	burst       int
+	limiters    sync.Map // limiterKey -> *Limiter
+}
+
+type limiterKey struct {
+	accountID string
+	region    string
+	rate      float64
+}
+
+// Scope identifies where calls are made, and with what rates.
+// Each provider configuration has its own Scope, so the rates configured for one don't affect another.
+type Scope struct {
+	AccountID string
+	Region    string
+	// Rates overrides the rates, in calls per second, of the named operations.
+	// Operations that are not specified have their default rates.
+	Rates map[string]float64
+}
+
+// Register returns the Limiters for the named operation, creating them with the default rate, in calls per second,
+// and burst size if necessary.
+// Register is expected to be called during package initialization.
+func Register(operation string, defaultRate float64, burst int) *Limiters {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if ls, ok := registry[operation]; ok {
+		return ls
+	}
+
+	ls := &Limiters{
+		operation:   operation,
+		defaultRate: defaultRate,
+		burst:       burst,
+	}
+	registry[operation] = ls
+
+	return ls
+}
+
+// For returns the Limiter for calls made in the scope's account and Region, at the scope's rate for the operation.
+// Scopes with the same account, Region and rate share a Limiter.
+func (ls *Limiters) For(scope Scope) *Limiter {
+	r, ok := scope.Rates[ls.operation]
+	if !ok {
+		r = ls.defaultRate
+	}
+	key := limiterKey{
+		accountID: scope.AccountID,
+		region:    scope.Region,
+		rate:      r,
+	}
+
+	if v, ok := ls.limiters.Load(key); ok {
+		return v.(*Limiter)
+	}
+
+	v, _ := ls.limiters.LoadOrStore(key, newLimiter(r, ls.burst))
+
+	return v.(*Limiter)
+}
+
+// ValidateRates returns an error if rates, in calls per second keyed by operation, names an unregistered operation
+// or has a rate that is not positive.
+func ValidateRates(rates map[string]float64) error {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	for _, operation := range slices.Sorted(maps.Keys(rates)) {
+		if _, ok := registry[operation]; !ok {
+			return fmt.Errorf("unsupported operation %q, expected one of %s", operation, strings.Join(slices.Sorted(maps.Keys(registry)), ", "))
+		}
+		if r := rates[operation]; r <= 0 {
+			return fmt.Errorf("rate for operation %q must be greater than 0, got %v", operation, r)
+		}
+	}
+
+	return nil
+}
+
+// Limiter limits the rate of calls to a single AWS API operation in a single account and Region.
+// Calls are allowed in bursts up to the Limiter's burst size, then at the sustained rate.
+// The sustained rate adapts to throttling: it backs off multiplicatively whenever a call is throttled
+// and recovers additively, up to the maximum rate, as calls proceed.
+type Limiter struct {
+	mutex     sync.Mutex
+	maxRate   float64
+	limiter   *rate.Limiter
+	throttles int64
+}
+
+func newLimiter(r float64, burst int) *Limiter {
+	return &Limiter{
+		maxRate: r,
+		limiter: rate.NewLimiter(rate.Limit(r), burst),
+	}
+}
+
+// Wait blocks until the Limiter allows another call, or until the context is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if err := l.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.limiter.SetLimit(min(l.limiter.Limit()+rate.Limit(l.maxRate*recoveryRateFactor), rate.Limit(l.maxRate)))
+
+	return nil
+}
+
+// Throttled reduces the Limiter's rate after a call has been throttled.
+func (l *Limiter) Throttled() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.throttles++
+	l.limiter.SetLimit(max(l.limiter.Limit()*throttledRateFactor, rate.Limit(min(minimumRate, l.maxRate))))
+}
+
+// Throttles returns the number of throttled calls the Limiter has observed.
+func (l *Limiter) Throttles() int64 {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.throttles
+}
+
+// ObserveThrottling returns a retryer that reports throttling errors to the Limiter
+// before delegating to the specified retryer.
+func (l *Limiter) ObserveThrottling(r aws.RetryerV2) aws.RetryerV2 {
+	return &throttlingObserver{
+		RetryerV2: r,
+		limiter:   l,
+	}
+}
+
+type throttlingObserver struct {
+	aws.RetryerV2
+	limiter *Limiter
+}
+
+func (r *throttlingObserver) IsErrorRetryable(err error) bool {
+	if isThrottlingError(err) {
+		r.limiter.Throttled()
+	}
+	return r.RetryerV2.IsErrorRetryable(err)
+}
@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+)
+
+func TestValidateRates(t *testing.T) {
+	t.Parallel()
+
+	Register("TestValidateRates", 1, 1)
+
+	testCases := map[string]struct {
+		rates       map[string]float64
+		expectError bool
+	}{
+		"nil": {},
+		"override": {
+			rates: map[string]float64{
+				"TestValidateRates": 20,
+			},
+		},
+		"unknown operation": {
+			rates: map[string]float64{
+				"Unknown": 20,
+			},
+			expectError: true,
+		},
+		"zero rate": {
+			rates: map[string]float64{
+				"TestValidateRates": 0,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateRates(testCase.rates)
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("expected error: %t, got %v", want, err)
+			}
+		})
+	}
+}
+
+func TestRegister_existing(t *testing.T) {
+	t.Parallel()
+
+	ls1 := Register("TestRegister_existing", 1, 1)
+	ls2 := Register("TestRegister_existing", 2, 2)
+
+	if ls1 != ls2 {
+		t.Errorf("expected the same limiters to be returned")
+	}
+	if got, want := ls2.defaultRate, 1.0; got != want {
+		t.Errorf("expected rate %v, got %v", want, got)
+	}
+}
+
+func TestLimitersFor(t *testing.T) {
+	t.Parallel()
+
+	ls := &Limiters{
+		operation:   "TestLimitersFor",
+		defaultRate: 1,
+		burst:       1,
+	}
+
+	l := ls.For(Scope{AccountID: "123456789012", Region: "us-west-2"}) //lintignore:AWSAT003
+
+	if ls.For(Scope{AccountID: "123456789012", Region: "us-west-2"}) != l { //lintignore:AWSAT003
+		t.Errorf("expected the same limiter for the same account and Region")
+	}
+	if ls.For(Scope{AccountID: "123456789012", Region: "us-east-1"}) == l { //lintignore:AWSAT003
+		t.Errorf("expected a different limiter for a different Region")
+	}
+	if ls.For(Scope{AccountID: "210987654321", Region: "us-west-2"}) == l { //lintignore:AWSAT003
+		t.Errorf("expected a different limiter for a different account")
+	}
+
+	// Exhaust the burst of one limiter; the others are unaffected.
+	if err := l.Wait(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if l.limiter.Tokens() >= 1 {
+		t.Errorf("expected limiter to be exhausted")
+	}
+	if ls.For(Scope{AccountID: "123456789012", Region: "us-east-1"}).limiter.Tokens() < 1 { //lintignore:AWSAT003
+		t.Errorf("expected limiter for a different Region not to be exhausted")
+	}
+}
+
+func TestLimitersFor_rates(t *testing.T) {
+	t.Parallel()
+
+	ls := &Limiters{
+		operation:   "TestLimitersFor_rates",
+		defaultRate: 1,
+		burst:       1,
+	}
+
+	defaultScope := Scope{AccountID: "123456789012", Region: "us-west-2"} //lintignore:AWSAT003
+	overrideScope := defaultScope
+	overrideScope.Rates = map[string]float64{
+		"TestLimitersFor_rates": 20,
+		"Other":                 5,
+	}
+
+	l := ls.For(overrideScope)
+	if got, want := float64(l.limiter.Limit()), 20.0; got != want {
+		t.Errorf("expected rate %v, got %v", want, got)
+	}
+
+	// A scope without the override, such as another provider configuration's, keeps the default rate.
+	if got, want := float64(ls.For(defaultScope).limiter.Limit()), 1.0; got != want {
+		t.Errorf("expected rate %v, got %v", want, got)
+	}
+	if ls.For(overrideScope) != l {
+		t.Errorf("expected the same limiter for the same account, Region and rate")
+	}
+}
+
+func TestLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rate        float64
+		burst       int
+		calls       int
+		minDuration time.Duration
+		maxDuration time.Duration
+	}{
+		"within burst": {
+			rate:        1,
+			burst:       3,
+			calls:       3,
+			maxDuration: 500 * time.Millisecond,
+		},
+		"beyond burst": {
+			rate:        10,
+			burst:       1,
+			calls:       3,
+			minDuration: 200 * time.Millisecond,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			l := newLimiter(testCase.rate, testCase.burst)
+
+			start := time.Now()
+			for range testCase.calls {
+				if err := l.Wait(t.Context()); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+			elapsed := time.Since(start)
+
+			if elapsed < testCase.minDuration {
+				t.Errorf("expected at least %s, took %s", testCase.minDuration, elapsed)
+			}
+			if testCase.maxDuration > 0 && elapsed > testCase.maxDuration {
+				t.Errorf("expected at most %s, took %s", testCase.maxDuration, elapsed)
+			}
+		})
+	}
+}
+
+func TestLimiterWait_canceled(t *testing.T) {
+	t.Parallel()
+
+	l := newLimiter(0.001, 1)
+	if err := l.Wait(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %s, got %v", context.Canceled, err)
+	}
+}
+
+func TestLimiterThrottled(t *testing.T) {
+	t.Parallel()
+
+	l := newLimiter(8, 100)
+
+	l.Throttled()
+	if got, want := float64(l.limiter.Limit()), 4.0; got != want {
+		t.Errorf("expected rate %v after throttling, got %v", want, got)
+	}
+	if got, want := l.Throttles(), int64(1); got != want {
+		t.Errorf("expected %d throttles, got %d", want, got)
+	}
+
+	for range 2 {
+		if err := l.Wait(t.Context()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if got, want := float64(l.limiter.Limit()), 4.8; math.Abs(got-want) > 1e-9 {
+		t.Errorf("expected rate %v after recovering, got %v", want, got)
+	}
+
+	for range 100 {
+		l.Throttled()
+	}
+	if got, want := float64(l.limiter.Limit()), minimumRate; got != want {
+		t.Errorf("expected minimum rate %v, got %v", want, got)
+	}
+	if got, want := l.Throttles(), int64(101); got != want {
+		t.Errorf("expected %d throttles, got %d", want, got)
+	}
+
+	l = newLimiter(8, 100)
+	l.Throttled()
+	for range 50 {
+		if err := l.Wait(t.Context()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if got, want := float64(l.limiter.Limit()), 8.0; got != want {
+		t.Errorf("expected maximum rate %v, got %v", want, got)
+	}
+}
+
+func TestLimiterObserveThrottling(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err          error
+		expectedRate float64
+	}{
+		"throttling error": {
+			err:          &smithy.GenericAPIError{Code: "ThrottlingException"},
+			expectedRate: 4,
+		},
+		"other error": {
+			err:          &smithy.GenericAPIError{Code: "ValidationException"},
+			expectedRate: 8,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			l := newLimiter(8, 1)
+			r := l.ObserveThrottling(retry.NewStandard())
+
+			r.IsErrorRetryable(testCase.err)
+
+			if got, want := float64(l.limiter.Limit()), testCase.expectedRate; got != want {
+				t.Errorf("expected rate %v, got %v", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+)
+
+const (
+	// Throttled calls are attempted at most this many times...
+	throttledMaxAttempts = 5
+	// ...sleeping for up to this long before the second attempt, doubling with each subsequent attempt...
+	throttledBaseDelay = 1 * time.Second
+	// ...but never for more than this long.
+	throttledMaxDelay = 30 * time.Second
+)
+
+// ThrottledError is returned by RetryThrottled when a call is still throttled after the maximum number of attempts.
+type ThrottledError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *ThrottledError) Error() string {
+	return fmt.Sprintf("still throttled after %d attempts: %s", e.Attempts, e.Err)
+}
+
+func (e *ThrottledError) Unwrap() error {
+	return e.Err
+}
+
+// RetryThrottled calls f until it succeeds, fails with an error other than throttling, or has been throttled 5 times.
+// Between throttled attempts it sleeps for exponentially increasing intervals with full jitter, capped at 30 seconds.
+// This is in addition to any retries made by the AWS SDK's retryer, for bursts that the Limiters don't prevent.
+// If f is still throttled after the last attempt, a *ThrottledError is returned.
+func RetryThrottled[T any](ctx context.Context, f func(context.Context) (T, error)) (T, error) {
+	return retryThrottled(ctx, f, backoff.ExponentialJitterDelay(throttledBaseDelay, throttledMaxDelay))
+}
+
+func retryThrottled[T any](ctx context.Context, f func(context.Context) (T, error), delay backoff.Delay) (T, error) {
+	var zero T
+
+	for n := uint(0); ; n++ {
+		if err := sleep(ctx, delay.Next(n)); err != nil {
+			return zero, err
+		}
+
+		v, err := f(ctx)
+		if err == nil || !isThrottlingError(err) {
+			return v, err
+		}
+
+		if n+1 >= throttledMaxAttempts {
+			return zero, &ThrottledError{
+				Attempts: int(n + 1),
+				Err:      err,
+			}
+		}
+	}
+}
+
+// Call calls f once the Limiter allows it, retrying throttled calls as RetryThrottled does.
+// Each throttled call also slows the Limiter down, so that other calls it limits back off too.
+func Call[T any](ctx context.Context, l *Limiter, f func(context.Context) (T, error)) (T, error) {
+	return call(ctx, l, f, backoff.ExponentialJitterDelay(throttledBaseDelay, throttledMaxDelay))
+}
+
+func call[T any](ctx context.Context, l *Limiter, f func(context.Context) (T, error), delay backoff.Delay) (T, error) {
+	return retryThrottled(ctx, func(ctx context.Context) (T, error) {
+		if err := l.Wait(ctx); err != nil {
+			var zero T
+			return zero, err
+		}
+
+		v, err := f(ctx)
+		if err != nil && isThrottlingError(err) {
+			l.Throttled()
+		}
+
+		return v, err
+	}, delay)
+}
+
+// isThrottlingError returns whether the specified error is the result of a throttled call.
+func isThrottlingError(err error) bool {
+	return retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool()
+}
+
+// sleep sleeps for the specified duration, or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
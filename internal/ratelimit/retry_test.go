@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+)
+
+func TestRetryThrottled(t *testing.T) {
+	t.Parallel()
+
+	throttlingErr := &smithy.GenericAPIError{Code: "ThrottlingException"}
+	validationErr := &smithy.GenericAPIError{Code: "ValidationException"}
+
+	testCases := map[string]struct {
+		errs              []error
+		expectedCalls     int
+		expectedErr       error
+		expectedThrottled bool
+	}{
+		"success": {
+			expectedCalls: 1,
+		},
+		"throttled then success": {
+			errs:          []error{throttlingErr, throttlingErr},
+			expectedCalls: 3,
+		},
+		"other error": {
+			errs:          []error{validationErr},
+			expectedCalls: 1,
+			expectedErr:   validationErr,
+		},
+		"throttled then other error": {
+			errs:          []error{throttlingErr, validationErr},
+			expectedCalls: 2,
+			expectedErr:   validationErr,
+		},
+		"always throttled": {
+			errs:              []error{throttlingErr, throttlingErr, throttlingErr, throttlingErr, throttlingErr, throttlingErr},
+			expectedCalls:     throttledMaxAttempts,
+			expectedErr:       throttlingErr,
+			expectedThrottled: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			f := func(context.Context) (int, error) {
+				calls++
+				if calls <= len(testCase.errs) {
+					return 0, testCase.errs[calls-1]
+				}
+				return 42, nil
+			}
+
+			got, err := retryThrottled(t.Context(), f, backoff.ZeroDelay)
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("expected %d calls, got %d", testCase.expectedCalls, calls)
+			}
+
+			if testCase.expectedErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if got != 42 {
+					t.Errorf("expected 42, got %d", got)
+				}
+				return
+			}
+
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected %s, got %v", testCase.expectedErr, err)
+			}
+			var throttledErr *ThrottledError
+			if got := errors.As(err, &throttledErr); got != testCase.expectedThrottled {
+				t.Errorf("expected ThrottledError %t, got %t", testCase.expectedThrottled, got)
+			}
+		})
+	}
+}
+
+func TestRetryThrottled_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	var calls int
+	f := func(context.Context) (int, error) {
+		calls++
+		cancel()
+		return 0, &smithy.GenericAPIError{Code: "ThrottlingException"}
+	}
+
+	_, err := retryThrottled(ctx, f, backoff.FixedDelay(throttledMaxDelay))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %s, got %v", context.Canceled, err)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestCall(t *testing.T) {
+	t.Parallel()
+
+	l := newLimiter(100, 100)
+
+	var calls int
+	f := func(context.Context) (int, error) {
+		calls++
+		if calls < 3 {
+			return 0, &smithy.GenericAPIError{Code: "ThrottlingException"}
+		}
+		return 42, nil
+	}
+
+	got, err := call(t.Context(), l, f, backoff.ZeroDelay)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != 42 {
+		t.Errorf("expected 42, got %d", got)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+	if got, want := l.Throttles(), int64(2); got != want {
+		t.Errorf("expected %d throttles, got %d", want, got)
+	}
+}
+
+func TestCall_otherError(t *testing.T) {
+	t.Parallel()
+
+	l := newLimiter(100, 100)
+	validationErr := &smithy.GenericAPIError{Code: "ValidationException"}
+
+	_, err := call(t.Context(), l, func(context.Context) (int, error) {
+		return 0, validationErr
+	}, backoff.ZeroDelay)
+	if !errors.Is(err, validationErr) {
+		t.Errorf("expected %s, got %v", validationErr, err)
+	}
+	if got := l.Throttles(); got != 0 {
+		t.Errorf("expected no throttles, got %d", got)
+	}
+}
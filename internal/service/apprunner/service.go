@@ -7,6 +7,7 @@ package apprunner
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -499,12 +500,20 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "reading App Runner Service (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceServiceFlatten(ctx, conn, service, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceServiceFlatten(ctx context.Context, conn *apprunner.Client, service *types.Service, d *schema.ResourceData) error {
 	serviceURL := aws.ToString(service.ServiceUrl)
 
 	if serviceURL == "" {
 		// Alternate lookup required for private services.
 		input := &apprunner.DescribeCustomDomainsInput{
-			ServiceArn: aws.String(d.Id()),
+			ServiceArn: service.ServiceArn,
 		}
 
 		err := forEachCustomDomainPage(ctx, conn, input, func(page *apprunner.DescribeCustomDomainsOutput) {
@@ -512,7 +521,7 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any)
 		})
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading App Runner Service (%s) custom domains: %s", d.Id(), err)
+			return fmt.Errorf("reading App Runner Service (%s) custom domains: %w", aws.ToString(service.ServiceArn), err)
 		}
 	}
 
@@ -523,29 +532,29 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any)
 		d.Set("auto_scaling_configuration_arn", nil)
 	}
 	if err := d.Set(names.AttrEncryptionConfiguration, flattenServiceEncryptionConfiguration(service.EncryptionConfiguration)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting encryption_configuration: %s", err)
+		return fmt.Errorf("setting encryption_configuration: %w", err)
 	}
 	if err := d.Set("health_check_configuration", flattenServiceHealthCheckConfiguration(service.HealthCheckConfiguration)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting health_check_configuration: %s", err)
+		return fmt.Errorf("setting health_check_configuration: %w", err)
 	}
 	if err := d.Set("instance_configuration", flattenServiceInstanceConfiguration(service.InstanceConfiguration)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting instance_configuration: %s", err)
+		return fmt.Errorf("setting instance_configuration: %w", err)
 	}
 	if err := d.Set(names.AttrNetworkConfiguration, flattenNetworkConfiguration(service.NetworkConfiguration)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
+		return fmt.Errorf("setting network_configuration: %w", err)
 	}
 	if err := d.Set("observability_configuration", flattenServiceObservabilityConfiguration(service.ObservabilityConfiguration)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting observability_configuration: %s", err)
+		return fmt.Errorf("setting observability_configuration: %w", err)
 	}
 	d.Set("service_id", service.ServiceId)
 	d.Set(names.AttrServiceName, service.ServiceName)
 	d.Set("service_url", serviceURL)
 	if err := d.Set("source_configuration", flattenServiceSourceConfiguration(service.SourceConfiguration)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting source_configuration: %s", err)
+		return fmt.Errorf("setting source_configuration: %w", err)
 	}
 	d.Set(names.AttrStatus, service.Status)
 
-	return diags
+	return nil
 }
 
 func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
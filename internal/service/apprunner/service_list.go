@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_apprunner_service")
+func newServiceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := serviceListResource{}
+	l.SetResourceSchema(resourceService())
+
+	return &l
+}
+
+var _ list.ListResource = &serviceListResource{}
+
+type serviceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type serviceListResourceModel struct {
+	framework.WithRegionModel
+	Status types.String `tfsdk:"status"`
+}
+
+func (l *serviceListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrStatus: listschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.ServiceStatusRunning, awstypes.ServiceStatusPaused)...),
+				},
+			},
+		},
+	}
+}
+
+func (l *serviceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.AppRunnerClient(ctx)
+
+	var query serviceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	filter := serviceStatusFilter(query.Status)
+
+	tflog.Info(ctx, "Listing App Runner Services")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		describeServiceLimiter := describeServiceRateLimiters.For(awsClient.RateLimitScope(ctx))
+		listTagsLimiter := listTagsForResourceRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input apprunner.ListServicesInput
+		for page, err := range listServicePages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			page = tfslices.Filter(page, func(v awstypes.ServiceSummary) bool {
+				return filter(&v)
+			})
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				arns := tfslices.ApplyToAll(page, func(v awstypes.ServiceSummary) string {
+					return aws.ToString(v.ServiceArn)
+				})
+
+				tags, err = findServiceTags(ctx, conn, listTagsLimiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, summary := range page {
+				arn := aws.ToString(summary.ServiceArn)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(arn)
+				rd.Set(names.AttrARN, arn)
+
+				if request.IncludeResource {
+					// Services aren't read once listing has been canceled.
+					if err := ctx.Err(); err != nil {
+						result := fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+
+					tflog.Info(ctx, "Reading App Runner Service")
+					service, err := ratelimit.Call(ctx, describeServiceLimiter, func(ctx context.Context) (*awstypes.Service, error) {
+						return findServiceByARN(ctx, conn, arn)
+					})
+					if retry.NotFound(err) {
+						continue
+					}
+					if err != nil {
+						tflog.Error(ctx, "Reading App Runner Service", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					if err := resourceServiceFlatten(ctx, conn, service, rd); err != nil {
+						tflog.Error(ctx, "Reading App Runner Service", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tags[arn]))
+				}
+
+				result.DisplayName = aws.ToString(summary.ServiceName)
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// serviceStatusFilter returns a predicate selecting services with the specified status.
+// A null value selects all services.
+func serviceStatusFilter(status types.String) tfslices.Predicate[*awstypes.ServiceSummary] {
+	if status.IsNull() || status.IsUnknown() {
+		return tfslices.PredicateTrue[*awstypes.ServiceSummary]()
+	}
+
+	s := awstypes.ServiceStatus(status.ValueString())
+	return func(v *awstypes.ServiceSummary) bool {
+		return v.Status == s
+	}
+}
+
+func listServicePages(ctx context.Context, conn *apprunner.Client, input *apprunner.ListServicesInput) iter.Seq2[[]awstypes.ServiceSummary, error] {
+	return func(yield func([]awstypes.ServiceSummary, error) bool) {
+		pages := apprunner.NewListServicesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing App Runner Services: %w", err))
+				return
+			}
+
+			if !yield(page.ServiceSummaryList, nil) {
+				return
+			}
+		}
+	}
+}
+
+// findServiceTags returns the tags of each of the specified services, keyed by ARN.
+// App Runner has no batch tagging API, so ListTagsForResource is called once per service.
+func findServiceTags(ctx context.Context, conn *apprunner.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for App Runner Service (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
+
+// describeServiceRateLimiters and listTagsForResourceRateLimiters limit the calls made to hydrate listed services,
+// each to 10 per second by default.
+var (
+	describeServiceRateLimiters     = ratelimit.Register("apprunner:DescribeService", 10, 10)
+	listTagsForResourceRateLimiters = ratelimit.Register("apprunner:ListTagsForResource", 10, 10)
+)
@@ -44,6 +44,11 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 )
 
 // @SDKResource("aws_autoscaling_group", name="Group")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/autoscaling/types;awstypes;awstypes.AutoScalingGroup")
+// @Testing(idAttrDuplicates="name")
+// @Testing(importIgnore="force_delete;ignore_failed_scaling_activities;wait_for_capacity_timeout")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
@@ -51,10 +56,6 @@ func resourceGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceGroupUpdate,
 		DeleteWithoutTimeout: resourceGroupDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -1327,7 +1328,6 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta any)
 func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AutoScalingClient(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	g, err := findGroupByName(ctx, conn, d.Id())
 
@@ -1341,6 +1341,16 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceGroupFlatten(ctx, meta.(*conns.AWSClient), g, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceGroupFlatten(ctx context.Context, awsClient *conns.AWSClient, g *awstypes.AutoScalingGroup, d *schema.ResourceData) error {
+	ignoreTagsConfig := awsClient.IgnoreTagsConfig(ctx)
+
 	d.Set(names.AttrARN, g.AutoScalingGroupARN)
 	d.Set(names.AttrAvailabilityZones, g.AvailabilityZones)
 	d.Set("availability_zone_distribution", []any{flattenAvailabilityZoneDistribution(g.AvailabilityZoneDistribution)})
@@ -1361,12 +1371,12 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	d.Set("health_check_grace_period", g.HealthCheckGracePeriod)
 	d.Set("health_check_type", g.HealthCheckType)
 	if err := d.Set("instance_maintenance_policy", flattenInstanceMaintenancePolicy(g.InstanceMaintenancePolicy)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting instance_maintenance_policy: %s", err)
+		return fmt.Errorf("setting instance_maintenance_policy: %w", err)
 	}
 	d.Set("launch_configuration", g.LaunchConfigurationName)
 	if g.LaunchTemplate != nil {
 		if err := d.Set(names.AttrLaunchTemplate, []any{flattenLaunchTemplateSpecification(g.LaunchTemplate)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting launch_template: %s", err)
+			return fmt.Errorf("setting launch_template: %w", err)
 		}
 	} else {
 		d.Set(names.AttrLaunchTemplate, nil)
@@ -1377,7 +1387,7 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	d.Set("min_size", g.MinSize)
 	if g.MixedInstancesPolicy != nil {
 		if err := d.Set("mixed_instances_policy", []any{flattenMixedInstancesPolicy(g.MixedInstancesPolicy)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting mixed_instances_policy: %s", err)
+			return fmt.Errorf("setting mixed_instances_policy: %w", err)
 		}
 	} else {
 		d.Set("mixed_instances_policy", nil)
@@ -1390,7 +1400,7 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	d.Set("service_linked_role_arn", g.ServiceLinkedRoleARN)
 	d.Set("suspended_processes", flattenSuspendedProcesses(g.SuspendedProcesses))
 	if err := d.Set("traffic_source", flattenTrafficSourceIdentifiers(g.TrafficSources)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting traffic_source: %s", err)
+		return fmt.Errorf("setting traffic_source: %w", err)
 	}
 	d.Set("target_group_arns", g.TargetGroupARNs)
 	// If no termination polices are explicitly configured and the upstream state
@@ -1408,7 +1418,7 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 	if g.WarmPoolConfiguration != nil {
 		if err := d.Set("warm_pool", []any{flattenWarmPoolConfiguration(g.WarmPoolConfiguration)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting warm_pool: %s", err)
+			return fmt.Errorf("setting warm_pool: %w", err)
 		}
 	} else {
 		d.Set("warm_pool", nil)
@@ -1416,10 +1426,10 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	d.Set("warm_pool_size", g.WarmPoolSize)
 
 	if err := d.Set("tag", listOfMap(keyValueTags(ctx, g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig))); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting tag: %s", err)
+		return fmt.Errorf("setting tag: %w", err)
 	}
 
-	return diags
+	return nil
 }
 
 func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
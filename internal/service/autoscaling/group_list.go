@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_autoscaling_group")
+func newGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := groupListResource{}
+	l.SetResourceSchema(resourceGroup())
+
+	return &l
+}
+
+var _ list.ListResource = &groupListResource{}
+
+type groupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type groupListResourceModel struct {
+	framework.WithRegionModel
+	MinSizeGT types.Int64 `tfsdk:"min_size_gt"`
+}
+
+func (l *groupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"min_size_gt": listschema.Int64Attribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *groupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.AutoScalingClient(ctx)
+
+	var query groupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	filter := groupMinSizeGTFilter(query.MinSizeGT)
+
+	tflog.Info(ctx, "Listing Auto Scaling Groups")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input autoscaling.DescribeAutoScalingGroupsInput
+		for group, err := range listGroups(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if !filter(&group) {
+				continue
+			}
+
+			name := aws.ToString(group.AutoScalingGroupName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), name)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(name)
+			rd.Set(names.AttrName, name)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading Auto Scaling Group")
+				if err := resourceGroupFlatten(ctx, awsClient, &group, rd); err != nil {
+					tflog.Error(ctx, "Reading Auto Scaling Group", map[string]any{
+						"error": err.Error(),
+					})
+					continue
+				}
+			}
+
+			result.DisplayName = name
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// groupMinSizeGTFilter returns a predicate selecting groups whose minimum size is greater than minSize.
+// A null value selects all groups.
+func groupMinSizeGTFilter(minSize types.Int64) tfslices.Predicate[*awstypes.AutoScalingGroup] {
+	if minSize.IsNull() || minSize.IsUnknown() {
+		return tfslices.PredicateTrue[*awstypes.AutoScalingGroup]()
+	}
+
+	threshold := minSize.ValueInt64()
+	return func(v *awstypes.AutoScalingGroup) bool {
+		return int64(aws.ToInt32(v.MinSize)) > threshold
+	}
+}
+
+func listGroups(ctx context.Context, conn *autoscaling.Client, input *autoscaling.DescribeAutoScalingGroupsInput) iter.Seq2[awstypes.AutoScalingGroup, error] {
+	return func(yield func(awstypes.AutoScalingGroup, error) bool) {
+		pages := autoscaling.NewDescribeAutoScalingGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.AutoScalingGroup{}, fmt.Errorf("listing Auto Scaling Groups: %w", err))
+				return
+			}
+
+			for _, group := range page.AutoScalingGroups {
+				if !yield(group, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -36,16 +36,17 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 )
 
 // @SDKResource("aws_launch_configuration", name="Launch Configuration")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/autoscaling/types;awstypes;awstypes.LaunchConfiguration")
+// @Testing(idAttrDuplicates="name")
+// @Testing(importIgnore="ephemeral_block_device")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceLaunchConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLaunchConfigurationCreate,
 		ReadWithoutTimeout:   resourceLaunchConfigurationRead,
 		DeleteWithoutTimeout: resourceLaunchConfigurationDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -443,6 +444,14 @@ func resourceLaunchConfigurationRead(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Launch Configuration (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceLaunchConfigurationFlatten(ctx, ec2conn, lc, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceLaunchConfigurationFlatten(ctx context.Context, ec2conn *ec2.Client, lc *awstypes.LaunchConfiguration, d *schema.ResourceData) error {
 	d.Set(names.AttrARN, lc.LaunchConfigurationARN)
 	d.Set("associate_public_ip_address", lc.AssociatePublicIpAddress)
 	d.Set("ebs_optimized", lc.EbsOptimized)
@@ -457,7 +466,7 @@ func resourceLaunchConfigurationRead(ctx context.Context, d *schema.ResourceData
 	d.Set("key_name", lc.KeyName)
 	if lc.MetadataOptions != nil {
 		if err := d.Set("metadata_options", []any{flattenInstanceMetadataOptions(lc.MetadataOptions)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting metadata_options: %s", err)
+			return fmt.Errorf("setting metadata_options: %w", err)
 		}
 	} else {
 		d.Set("metadata_options", nil)
@@ -481,7 +490,7 @@ func resourceLaunchConfigurationRead(ctx context.Context, d *schema.ResourceData
 		// Don't block a refresh for a bad image.
 		rootDeviceName = ""
 	} else if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Launch Configuration (%s): %s", d.Id(), err)
+		return fmt.Errorf("reading Auto Scaling Launch Configuration (%s): %w", aws.ToString(lc.LaunchConfigurationName), err)
 	}
 
 	configuredEBSBlockDevices := make(map[string]map[string]any)
@@ -501,16 +510,16 @@ func resourceLaunchConfigurationRead(ctx context.Context, d *schema.ResourceData
 	tfListEBSBlockDevice, tfListEphemeralBlockDevice, tfListRootBlockDevice := flattenBlockDeviceMappings(lc.BlockDeviceMappings, rootDeviceName, configuredEBSBlockDevices)
 
 	if err := d.Set("ebs_block_device", tfListEBSBlockDevice); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ebs_block_device: %s", err)
+		return fmt.Errorf("setting ebs_block_device: %w", err)
 	}
 	if err := d.Set("ephemeral_block_device", tfListEphemeralBlockDevice); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ephemeral_block_device: %s", err)
+		return fmt.Errorf("setting ephemeral_block_device: %w", err)
 	}
 	if err := d.Set("root_block_device", tfListRootBlockDevice); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting root_block_device: %s", err)
+		return fmt.Errorf("setting root_block_device: %w", err)
 	}
 
-	return diags
+	return nil
 }
 
 func resourceLaunchConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
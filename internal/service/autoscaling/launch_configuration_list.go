@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_launch_configuration")
+func newLaunchConfigurationResourceAsListResource() inttypes.ListResourceForSDK {
+	l := launchConfigurationListResource{}
+	l.SetResourceSchema(resourceLaunchConfiguration())
+
+	return &l
+}
+
+var _ list.ListResource = &launchConfigurationListResource{}
+
+type launchConfigurationListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type launchConfigurationListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *launchConfigurationListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.AutoScalingClient(ctx)
+
+	var query launchConfigurationListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing Auto Scaling Launch Configurations")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input autoscaling.DescribeLaunchConfigurationsInput
+		if request.Limit > 0 && request.Limit < describeLaunchConfigurationsMaxRecords {
+			input.MaxRecords = aws.Int32(int32(request.Limit))
+		}
+
+		var count int64
+		for lc, err := range listLaunchConfigurations(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if request.Limit > 0 && count >= request.Limit {
+				return
+			}
+
+			name := aws.ToString(lc.LaunchConfigurationName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), name)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(name)
+			rd.Set(names.AttrName, name)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading Auto Scaling Launch Configuration")
+				if err := resourceLaunchConfigurationFlatten(ctx, awsClient.EC2Client(ctx), &lc, rd); err != nil {
+					tflog.Error(ctx, "Reading Auto Scaling Launch Configuration", map[string]any{
+						"error": err.Error(),
+					})
+					continue
+				}
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%s)", name, aws.ToString(lc.InstanceType))
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			count++
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+const (
+	// The maximum number of launch configurations that DescribeLaunchConfigurations returns in a single page.
+	describeLaunchConfigurationsMaxRecords = 100
+)
+
+func listLaunchConfigurations(ctx context.Context, conn *autoscaling.Client, input *autoscaling.DescribeLaunchConfigurationsInput) iter.Seq2[awstypes.LaunchConfiguration, error] {
+	return func(yield func(awstypes.LaunchConfiguration, error) bool) {
+		pages := autoscaling.NewDescribeLaunchConfigurationsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.LaunchConfiguration{}, fmt.Errorf("listing Auto Scaling Launch Configurations: %w", err))
+				return
+			}
+
+			for _, lc := range page.LaunchConfigurations {
+				if !yield(lc, nil) {
+					return
+				}
+			}
+		}
+	}
+}
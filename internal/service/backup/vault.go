@@ -35,6 +35,9 @@ import (
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/backup;backup.DescribeBackupVaultOutput")
 // @Testing(importIgnore="force_destroy")
+// @IdentityAttribute("name")
+// @Testing(idAttrDuplicates="name")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceVault() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVaultCreate,
@@ -42,10 +45,6 @@ func resourceVault() *schema.Resource {
 		UpdateWithoutTimeout: resourceVaultUpdate,
 		DeleteWithoutTimeout: resourceVaultDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
@@ -127,12 +126,16 @@ func resourceVaultRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		return sdkdiag.AppendErrorf(diags, "reading Backup Vault (%s): %s", d.Id(), err)
 	}
 
+	resourceVaultFlatten(d, output)
+
+	return diags
+}
+
+func resourceVaultFlatten(d *schema.ResourceData, output *backup.DescribeBackupVaultOutput) {
 	d.Set(names.AttrARN, output.BackupVaultArn)
 	d.Set(names.AttrKMSKeyARN, output.EncryptionKeyArn)
 	d.Set(names.AttrName, output.BackupVaultName)
 	d.Set("recovery_points", output.NumberOfRecoveryPoints)
-
-	return diags
 }
 
 func resourceVaultUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
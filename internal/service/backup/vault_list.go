@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_backup_vault")
+func newVaultResourceAsListResource() inttypes.ListResourceForSDK {
+	l := vaultListResource{}
+	l.SetResourceSchema(resourceVault())
+
+	return &l
+}
+
+var _ list.ListResource = &vaultListResource{}
+
+type vaultListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type vaultListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *vaultListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.BackupClient(ctx)
+
+	var query vaultListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	// Logically air-gapped vaults are managed by aws_backup_logically_air_gapped_vault.
+	input := backup.ListBackupVaultsInput{
+		ByVaultType: awstypes.VaultTypeBackupVault,
+	}
+
+	tflog.Info(ctx, "Listing Backup Vaults")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := listTagsRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		for page, err := range listVaultPages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				arns := make([]string, 0, len(page))
+				for _, vault := range page {
+					arns = append(arns, aws.ToString(vault.BackupVaultArn))
+				}
+
+				tags, err = findVaultTags(ctx, conn, limiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, vault := range page {
+				name := aws.ToString(vault.BackupVaultName)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), name)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(name)
+				rd.Set(names.AttrName, name)
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading Backup Vault")
+					// ListBackupVaults returns the same vault attributes as DescribeBackupVault.
+					resourceVaultFlatten(rd, &backup.DescribeBackupVaultOutput{
+						BackupVaultArn:         vault.BackupVaultArn,
+						BackupVaultName:        vault.BackupVaultName,
+						EncryptionKeyArn:       vault.EncryptionKeyArn,
+						NumberOfRecoveryPoints: vault.NumberOfRecoveryPoints,
+					})
+
+					setTagsOut(ctx, svcTags(tags[aws.ToString(vault.BackupVaultArn)]))
+				}
+
+				result.DisplayName = fmt.Sprintf("%s (%d recovery points)", name, vault.NumberOfRecoveryPoints)
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func listVaultPages(ctx context.Context, conn *backup.Client, input *backup.ListBackupVaultsInput) iter.Seq2[[]awstypes.BackupVaultListMember, error] {
+	return func(yield func([]awstypes.BackupVaultListMember, error) bool) {
+		pages := backup.NewListBackupVaultsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing Backup Vaults: %w", err))
+				return
+			}
+
+			if !yield(page.BackupVaultList, nil) {
+				return
+			}
+		}
+	}
+}
+
+// findVaultTags returns the tags of each of the specified vaults, keyed by ARN.
+// Backup has no batch tagging API, so ListTags is called once per vault.
+func findVaultTags(ctx context.Context, conn *backup.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for Backup Vault (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
+
+// listTagsRateLimiters limit the ListTags calls made to hydrate listed vaults, to 10 per second by default.
+var listTagsRateLimiters = ratelimit.Register("backup:ListTags", 10, 10)
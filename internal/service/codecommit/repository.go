@@ -28,6 +28,10 @@ import (
 
 // @SDKResource("aws_codecommit_repository", name="Repository")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("repository_name")
+// @Testing(idAttrDuplicates="repository_name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/codecommit/types;types.RepositoryMetadata")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceRepository() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRepositoryCreate,
@@ -35,10 +39,6 @@ func resourceRepository() *schema.Resource {
 		ReadWithoutTimeout:   resourceRepositoryRead,
 		DeleteWithoutTimeout: resourceRepositoryDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -133,20 +133,7 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, meta an
 		return sdkdiag.AppendErrorf(diags, "reading CodeCommit Repository (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, repository.Arn)
-	d.Set("clone_url_http", repository.CloneUrlHttp)
-	d.Set("clone_url_ssh", repository.CloneUrlSsh)
-	if _, ok := d.GetOk("default_branch"); ok {
-		// The default branch can only be set when there is code in the repository.
-		// Preserve the configured value.
-		if v := repository.DefaultBranch; v != nil { // nosemgrep:ci.helper-schema-ResourceData-Set-extraneous-nil-check
-			d.Set("default_branch", v)
-		}
-	}
-	d.Set(names.AttrDescription, repository.RepositoryDescription)
-	d.Set(names.AttrKMSKeyID, repository.KmsKeyId)
-	d.Set("repository_id", repository.RepositoryId)
-	d.Set(names.AttrRepositoryName, repository.RepositoryName)
+	resourceRepositoryFlatten(ctx, repository, d)
 
 	return diags
 }
@@ -252,6 +239,23 @@ func updateRepositoryDefaultBranch(ctx context.Context, conn *codecommit.Client,
 	return nil
 }
 
+func resourceRepositoryFlatten(_ context.Context, repository *types.RepositoryMetadata, d *schema.ResourceData) {
+	d.Set(names.AttrARN, repository.Arn)
+	d.Set("clone_url_http", repository.CloneUrlHttp)
+	d.Set("clone_url_ssh", repository.CloneUrlSsh)
+	if _, ok := d.GetOk("default_branch"); ok {
+		// The default branch can only be set when there is code in the repository.
+		// Preserve the configured value.
+		if v := repository.DefaultBranch; v != nil { // nosemgrep:ci.helper-schema-ResourceData-Set-extraneous-nil-check
+			d.Set("default_branch", v)
+		}
+	}
+	d.Set(names.AttrDescription, repository.RepositoryDescription)
+	d.Set(names.AttrKMSKeyID, repository.KmsKeyId)
+	d.Set("repository_id", repository.RepositoryId)
+	d.Set(names.AttrRepositoryName, repository.RepositoryName)
+}
+
 func findRepositoryByName(ctx context.Context, conn *codecommit.Client, name string) (*types.RepositoryMetadata, error) {
 	input := &codecommit.GetRepositoryInput{
 		RepositoryName: aws.String(name),
@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package codecommit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codecommit"
+	"github.com/aws/aws-sdk-go-v2/service/codecommit/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_codecommit_repository")
+func newRepositoryResourceAsListResource() inttypes.ListResourceForSDK {
+	l := repositoryListResource{}
+	l.SetResourceSchema(resourceRepository())
+
+	return &l
+}
+
+var _ list.ListResource = &repositoryListResource{}
+
+type repositoryListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type repositoryListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *repositoryListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.CodeCommitClient(ctx)
+
+	var query repositoryListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing CodeCommit Repositories")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		getRepositoryLimiter := getRepositoryRateLimiters.For(awsClient.RateLimitScope(ctx))
+		listTagsLimiter := listTagsForResourceRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input codecommit.ListRepositoriesInput
+		for page, err := range listRepositoryPages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// ListRepositories only returns repository names and IDs.
+			// The default branch is needed for the display name, so each repository is always read.
+			repositories := make([]*types.RepositoryMetadata, 0, len(page))
+			for _, item := range page {
+				name := aws.ToString(item.RepositoryName)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), name)
+
+				// Repositories aren't read once listing has been canceled.
+				if err := ctx.Err(); err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				tflog.Info(ctx, "Reading CodeCommit Repository")
+				repository, err := ratelimit.Call(ctx, getRepositoryLimiter, func(ctx context.Context) (*types.RepositoryMetadata, error) {
+					return findRepositoryByName(ctx, conn, name)
+				})
+				if retry.NotFound(err) {
+					continue
+				}
+				if err != nil {
+					tflog.Error(ctx, "Reading CodeCommit Repository", map[string]any{
+						"error": err.Error(),
+					})
+					continue
+				}
+
+				repositories = append(repositories, repository)
+			}
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				arns := make([]string, 0, len(repositories))
+				for _, repository := range repositories {
+					arns = append(arns, aws.ToString(repository.Arn))
+				}
+
+				tags, err = findRepositoryTags(ctx, conn, listTagsLimiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, repository := range repositories {
+				name := aws.ToString(repository.RepositoryName)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), name)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(name)
+				rd.Set(names.AttrRepositoryName, name)
+
+				if request.IncludeResource {
+					resourceRepositoryFlatten(ctx, repository, rd)
+					setTagsOut(ctx, svcTags(tags[aws.ToString(repository.Arn)]))
+				}
+
+				if v := aws.ToString(repository.DefaultBranch); v != "" {
+					result.DisplayName = fmt.Sprintf("%s (%s)", name, v)
+				} else {
+					result.DisplayName = name
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func listRepositoryPages(ctx context.Context, conn *codecommit.Client, input *codecommit.ListRepositoriesInput) iter.Seq2[[]types.RepositoryNameIdPair, error] {
+	return func(yield func([]types.RepositoryNameIdPair, error) bool) {
+		pages := codecommit.NewListRepositoriesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing CodeCommit Repositories: %w", err))
+				return
+			}
+
+			if !yield(page.Repositories, nil) {
+				return
+			}
+		}
+	}
+}
+
+// findRepositoryTags returns the tags of each of the specified repositories, keyed by ARN.
+func findRepositoryTags(ctx context.Context, conn *codecommit.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if errs.IsA[*types.RepositoryDoesNotExistException](err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for CodeCommit Repository (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
+
+// getRepositoryRateLimiters and listTagsForResourceRateLimiters limit the calls made to hydrate listed repositories,
+// each to 10 per second by default.
+var (
+	getRepositoryRateLimiters       = ratelimit.Register("codecommit:GetRepository", 10, 10)
+	listTagsForResourceRateLimiters = ratelimit.Register("codecommit:ListTagsForResource", 10, 10)
+)
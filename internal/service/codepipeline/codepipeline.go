@@ -42,7 +42,11 @@ const (
 
 // @SDKResource("aws_codepipeline", name="Pipeline")
 // @Tags(identifierAttribute="arn")
-// @Testing(existsTakesT=false, destroyTakesT=false)
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/codepipeline/types;types.PipelineDeclaration")
+// @Testing(idAttrDuplicates="name")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourcePipeline() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePipelineCreate,
@@ -50,10 +54,6 @@ func resourcePipeline() *schema.Resource {
 		UpdateWithoutTimeout: resourcePipelineUpdate,
 		DeleteWithoutTimeout: resourcePipelineDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaFunc: func() map[string]*schema.Schema {
 			conditionsSchema := func() map[string]*schema.Schema {
 				return map[string]*schema.Schema{
@@ -686,17 +686,25 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "reading CodePipeline Pipeline (%s): %s", d.Id(), err)
 	}
 
+	if err := resourcePipelineFlatten(d, output); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourcePipelineFlatten(d *schema.ResourceData, output *codepipeline.GetPipelineOutput) error {
 	metadata := output.Metadata
 	pipeline := output.Pipeline
 	arn := aws.ToString(metadata.PipelineArn)
 	d.Set(names.AttrARN, arn)
 	if pipeline.ArtifactStore != nil {
 		if err := d.Set("artifact_store", []any{flattenArtifactStore(pipeline.ArtifactStore)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting artifact_store: %s", err)
+			return fmt.Errorf("setting artifact_store: %w", err)
 		}
 	} else if pipeline.ArtifactStores != nil {
 		if err := d.Set("artifact_store", flattenArtifactStores(pipeline.ArtifactStores)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting artifact_store: %s", err)
+			return fmt.Errorf("setting artifact_store: %w", err)
 		}
 	}
 	d.Set("execution_mode", pipeline.ExecutionMode)
@@ -704,17 +712,17 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta any)
 	d.Set("pipeline_type", pipeline.PipelineType)
 	d.Set(names.AttrRoleARN, pipeline.RoleArn)
 	if err := d.Set(names.AttrStage, flattenStageDeclarations(d, pipeline.Stages)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting stage: %s", err)
+		return fmt.Errorf("setting stage: %w", err)
 	}
 	d.Set("trigger", d.Get("trigger"))
 	if err := d.Set("trigger_all", flattenTriggerDeclarations(pipeline.Triggers)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting trigger_all: %s", err)
+		return fmt.Errorf("setting trigger_all: %w", err)
 	}
 	if err := d.Set("variable", flattenVariableDeclarations(pipeline.Variables)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting variable: %s", err)
+		return fmt.Errorf("setting variable: %w", err)
 	}
 
-	return diags
+	return nil
 }
 
 func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
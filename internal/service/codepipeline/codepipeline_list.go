@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package codepipeline
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_codepipeline")
+func newPipelineResourceAsListResource() inttypes.ListResourceForSDK {
+	l := pipelineListResource{}
+	l.SetResourceSchema(resourcePipeline())
+
+	return &l
+}
+
+var _ list.ListResource = &pipelineListResource{}
+
+type pipelineListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type pipelineListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *pipelineListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.CodePipelineClient(ctx)
+
+	var query pipelineListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing CodePipeline Pipelines")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		getPipelineLimiter := getPipelineRateLimiters.For(awsClient.RateLimitScope(ctx))
+		listTagsLimiter := listTagsForResourceRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input codepipeline.ListPipelinesInput
+		for page, err := range listPipelinePages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// ListPipelines returns only pipeline names and versions, so the full declaration and tags
+			// are fetched for the whole page before any results are returned.
+			var pipelines map[string]*codepipeline.GetPipelineOutput
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				pipelines, err = findPipelinesBySummaries(ctx, conn, getPipelineLimiter, page)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				arns := make([]string, 0, len(pipelines))
+				for _, v := range pipelines {
+					arns = append(arns, aws.ToString(v.Metadata.PipelineArn))
+				}
+
+				tags, err = findPipelineTags(ctx, conn, listTagsLimiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, summary := range page {
+				name := aws.ToString(summary.Name)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), name)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(name)
+				rd.Set(names.AttrName, name)
+
+				if request.IncludeResource {
+					pipeline, ok := pipelines[name]
+					if !ok {
+						// Pipeline was deleted after it was listed.
+						continue
+					}
+
+					tflog.Info(ctx, "Reading CodePipeline Pipeline")
+					if err := resourcePipelineFlatten(rd, pipeline); err != nil {
+						tflog.Error(ctx, "Reading CodePipeline Pipeline", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tags[aws.ToString(pipeline.Metadata.PipelineArn)]))
+				}
+
+				result.DisplayName = name
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func listPipelinePages(ctx context.Context, conn *codepipeline.Client, input *codepipeline.ListPipelinesInput) iter.Seq2[[]types.PipelineSummary, error] {
+	return func(yield func([]types.PipelineSummary, error) bool) {
+		pages := codepipeline.NewListPipelinesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing CodePipeline Pipelines: %w", err))
+				return
+			}
+
+			if !yield(page.Pipelines, nil) {
+				return
+			}
+		}
+	}
+}
+
+// findPipelinesBySummaries returns the full declaration of each of the specified pipelines, keyed by name.
+// Pipelines that no longer exist are omitted.
+func findPipelinesBySummaries(ctx context.Context, conn *codepipeline.Client, limiter *ratelimit.Limiter, summaries []types.PipelineSummary) (map[string]*codepipeline.GetPipelineOutput, error) {
+	pipelines := make(map[string]*codepipeline.GetPipelineOutput, len(summaries))
+
+	for _, summary := range summaries {
+		name := aws.ToString(summary.Name)
+
+		output, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (*codepipeline.GetPipelineOutput, error) {
+			return findPipelineByName(ctx, conn, name)
+		})
+		if retry.NotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading CodePipeline Pipeline (%s): %w", name, err)
+		}
+
+		pipelines[name] = output
+	}
+
+	return pipelines, nil
+}
+
+// findPipelineTags returns the tags of each of the specified pipelines, keyed by ARN.
+// CodePipeline has no batch tagging API, so ListTagsForResource is called once per pipeline.
+func findPipelineTags(ctx context.Context, conn *codepipeline.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for CodePipeline Pipeline (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
+
+// getPipelineRateLimiters and listTagsForResourceRateLimiters limit the calls made to hydrate listed pipelines,
+// each to 10 per second by default.
+var (
+	getPipelineRateLimiters         = ratelimit.Register("codepipeline:GetPipeline", 10, 10)
+	listTagsForResourceRateLimiters = ratelimit.Register("codepipeline:ListTagsForResource", 10, 10)
+)
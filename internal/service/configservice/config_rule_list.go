@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/configservice/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_config_config_rule")
+func newConfigRuleResourceAsListResource() inttypes.ListResourceForSDK {
+	l := configRuleListResource{}
+	l.SetResourceSchema(resourceConfigRule())
+
+	return &l
+}
+
+var _ list.ListResource = &configRuleListResource{}
+
+type configRuleListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type configRuleListResourceModel struct {
+	framework.WithRegionModel
+	SourceOwner types.String `tfsdk:"source_owner"`
+}
+
+func (l *configRuleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"source_owner": listschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Values[awstypes.Owner]()...),
+				},
+			},
+		},
+	}
+}
+
+func (l *configRuleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.ConfigServiceClient(ctx)
+
+	var query configRuleListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	filter := configRuleSourceOwnerFilter(query.SourceOwner)
+
+	tflog.Info(ctx, "Listing ConfigService Config Rules")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := listTagsForResourceRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input configservice.DescribeConfigRulesInput
+		for page, err := range listConfigRulePages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			page = tfslices.Filter(page, filter)
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				arns := make([]string, 0, len(page))
+				for _, rule := range page {
+					arns = append(arns, aws.ToString(rule.ConfigRuleArn))
+				}
+
+				tags, err = findConfigRuleTags(ctx, conn, limiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, rule := range page {
+				name := aws.ToString(rule.ConfigRuleName)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), name)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(name)
+				rd.Set(names.AttrName, name)
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading ConfigService Config Rule")
+					// DescribeConfigRules returns the full rule configuration inline.
+					if err := resourceConfigRuleFlatten(ctx, &rule, rd); err != nil {
+						tflog.Error(ctx, "Reading ConfigService Config Rule", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tags[aws.ToString(rule.ConfigRuleArn)]))
+				}
+
+				if rule.Source != nil {
+					result.DisplayName = fmt.Sprintf("%s (%s)", name, rule.Source.Owner)
+				} else {
+					result.DisplayName = name
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// configRuleSourceOwnerFilter returns a predicate selecting config rules whose source has the specified owner.
+// A null value selects all config rules.
+func configRuleSourceOwnerFilter(sourceOwner types.String) tfslices.Predicate[awstypes.ConfigRule] {
+	if sourceOwner.IsNull() || sourceOwner.IsUnknown() {
+		return tfslices.PredicateTrue[awstypes.ConfigRule]()
+	}
+
+	owner := awstypes.Owner(sourceOwner.ValueString())
+	return func(v awstypes.ConfigRule) bool {
+		return v.Source != nil && v.Source.Owner == owner
+	}
+}
+
+func listConfigRulePages(ctx context.Context, conn *configservice.Client, input *configservice.DescribeConfigRulesInput) iter.Seq2[[]awstypes.ConfigRule, error] {
+	return func(yield func([]awstypes.ConfigRule, error) bool) {
+		pages := configservice.NewDescribeConfigRulesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing ConfigService Config Rules: %w", err))
+				return
+			}
+
+			if !yield(page.ConfigRules, nil) {
+				return
+			}
+		}
+	}
+}
+
+// findConfigRuleTags returns the tags of each of the specified config rules, keyed by ARN.
+func findConfigRuleTags(ctx context.Context, conn *configservice.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for ConfigService Config Rule (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
+
+// listTagsForResourceRateLimiters limit the ListTagsForResource calls made to hydrate listed Config rules, to 10 per second by default.
+var listTagsForResourceRateLimiters = ratelimit.Register("config:ListTagsForResource", 10, 10)
@@ -31,6 +31,8 @@ func TestAccConfigService_serial(t *testing.T) {
 			"scopeTagValue":      testAccConfigRule_Scope_TagValue,
 			"tags":               testAccConfigServiceConfigRule_tagsSerial,
 			acctest.CtDisappears: testAccConfigRule_disappears,
+			"Identity":           testAccConfigServiceConfigRule_identitySerial,
+			"List":               testAccConfigServiceConfigRule_listSerial,
 		},
 		"ConfigurationRecorderStatus": {
 			acctest.CtBasic:      testAccConfigurationRecorderStatus_basic,
@@ -0,0 +1,12 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_config_config_rule" "test" {
+  provider = aws
+
+  config {
+    source_owner = "AWS"
+  }
+
+  include_resource = true
+}
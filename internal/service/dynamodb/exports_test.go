@@ -30,10 +30,14 @@ var (
 	FindTableItemByTwoPartKey                    = findTableItemByTwoPartKey
 	FindTag                                      = findTag
 	FlattenTableItemAttributes                   = flattenTableItemAttributes
+	FlattenTTL                                   = flattenTTL
 	ListTags                                     = listTags
 	RegionFromARN                                = regionFromARN
+	SetTableStream                               = setTableStream
 	ReplicaForRegion                             = replicaForRegion
 	TableNameFromARN                             = tableNameFromARN
+	TableTTLStreamFromResourceData               = tableTTLStreamFromResourceData
+	TableTTLStreamSummary                        = tableTTLStreamSummary
 	TableReplicaParseResourceID                  = tableReplicaParseResourceID
 	UpdateDiffGSI                                = updateDiffGSI
 )
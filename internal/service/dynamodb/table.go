@@ -51,7 +51,10 @@ const (
 
 // @SDKResource("aws_dynamodb_table", name="Table")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/dynamodb/types;types.TableDescription")
+// @Testing(idAttrDuplicates="name")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceTable() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -60,10 +63,6 @@ func resourceTable() *schema.Resource {
 		UpdateWithoutTimeout: resourceTableUpdate,
 		DeleteWithoutTimeout: resourceTableDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(createTableTimeout),
 			Delete: schema.DefaultTimeout(deleteTableTimeout),
@@ -1001,14 +1000,7 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "on_demand_throughput", err)
 	}
 
-	if table.StreamSpecification != nil {
-		d.Set("stream_enabled", table.StreamSpecification.StreamEnabled)
-		d.Set("stream_view_type", table.StreamSpecification.StreamViewType)
-	} else {
-		d.Set("stream_enabled", false)
-		d.Set("stream_view_type", d.Get("stream_view_type").(string))
-	}
-
+	setTableStream(d, table.StreamSpecification)
 	d.Set(names.AttrStreamARN, table.LatestStreamArn)
 	d.Set("stream_label", table.LatestStreamLabel)
 
@@ -1082,6 +1074,17 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	return diags
 }
 
+// setTableStream sets a table's stream_enabled and stream_view_type from its stream specification.
+func setTableStream(d *schema.ResourceData, apiObject *awstypes.StreamSpecification) {
+	if apiObject != nil {
+		d.Set("stream_enabled", apiObject.StreamEnabled)
+		d.Set("stream_view_type", apiObject.StreamViewType)
+	} else {
+		d.Set("stream_enabled", false)
+		d.Set("stream_view_type", d.Get("stream_view_type").(string))
+	}
+}
+
 func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
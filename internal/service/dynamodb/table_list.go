@@ -0,0 +1,287 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_dynamodb_table")
+func newTableResourceAsListResource() inttypes.ListResourceForSDK {
+	l := tableListResource{}
+	l.SetResourceSchema(resourceTable())
+
+	return &l
+}
+
+var _ list.ListResource = &tableListResource{}
+
+type tableListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type tableListResourceModel struct {
+	framework.WithRegionModel
+	IncludeTTLStream types.Bool `tfsdk:"include_ttl_stream"`
+}
+
+func (l *tableListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"include_ttl_stream": listschema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *tableListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.DynamoDBClient(ctx)
+
+	var query tableListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing DynamoDB Tables")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := describeTableRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input dynamodb.ListTablesInput
+		if request.Limit > 0 && request.Limit < listTablesMaxLimit {
+			input.Limit = aws.Int32(int32(request.Limit))
+		}
+
+		var count int64
+		for page, err := range listTablePages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Tags are fetched for the whole page in as few Resource Groups Tagging API calls as possible
+			// rather than with one ListTagsOfResource call per table.
+			var tags map[string]map[string]string
+			var batchTagsErr error
+			if request.IncludeResource {
+				arns := make([]string, 0, len(page))
+				for _, name := range page {
+					arns = append(arns, newTableARN(ctx, awsClient, name))
+				}
+
+				tags, batchTagsErr = tftags.BatchFetchResourceTags(ctx, awsClient.ResourceGroupsTaggingAPIClient(ctx), awsClient.RateLimitScope(ctx), arns)
+				if batchTagsErr != nil {
+					// The page's tags are read one table at a time instead.
+					tflog.Warn(ctx, "Batch fetching DynamoDB Table tags", map[string]any{
+						"error": batchTagsErr.Error(),
+					})
+				}
+			}
+
+			for _, name := range page {
+				if request.Limit > 0 && count >= request.Limit {
+					return
+				}
+
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), name)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(name)
+				rd.Set(names.AttrName, name)
+
+				if request.IncludeResource {
+					if err := limiter.Wait(ctx); err != nil {
+						result := fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+
+					tflog.Info(ctx, "Reading DynamoDB Table")
+					diags := resourceTableRead(ctx, rd, awsClient)
+					if diags.HasError() {
+						tflog.Error(ctx, "Reading DynamoDB Table", map[string]any{
+							names.AttrName: name,
+							"diags":        sdkdiag.DiagnosticsString(diags),
+						})
+						continue
+					}
+					if rd.Id() == "" {
+						// Resource is logically deleted
+						continue
+					}
+
+					arn := newTableARN(ctx, awsClient, name)
+					tableTags := tftags.New(ctx, tags[arn])
+					if batchTagsErr != nil {
+						v, err := listTags(ctx, conn, arn)
+						if err != nil {
+							// The table is still listed, but without its tags.
+							result.Diagnostics.AddWarning(
+								"Error listing DynamoDB Table tags",
+								fmt.Sprintf("Tags could not be fetched for DynamoDB Table (%s), so it is listed without tags: %s", name, err),
+							)
+						}
+						tableTags = v
+					}
+					setTagsOut(ctx, svcTags(tableTags))
+				}
+
+				result.DisplayName = name
+
+				if query.IncludeTTLStream.ValueBool() {
+					// Unless the table has been read, its TTL and stream settings are read on their own.
+					var err error
+					if !request.IncludeResource {
+						err = readTableTTLStream(ctx, conn, limiter, rd)
+					}
+
+					if err != nil {
+						tflog.Warn(ctx, "Reading DynamoDB Table TTL and stream", map[string]any{
+							"error": err.Error(),
+						})
+					} else {
+						ttl, stream := tableTTLStreamFromResourceData(rd)
+						result.DisplayName = fmt.Sprintf("%s (%s)", name, tableTTLStreamSummary(ttl, stream))
+					}
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				count++
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+const (
+	// The maximum number of table names that ListTables returns in a single page.
+	listTablesMaxLimit = 100
+)
+
+func listTablePages(ctx context.Context, conn *dynamodb.Client, input *dynamodb.ListTablesInput) iter.Seq2[[]string, error] {
+	return func(yield func([]string, error) bool) {
+		pages := dynamodb.NewListTablesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing DynamoDB Tables: %w", err))
+				return
+			}
+
+			if !yield(page.TableNames, nil) {
+				return
+			}
+		}
+	}
+}
+
+// readTableTTLStream reads a table's Time to Live and stream settings into its resource data, as resourceTableRead does.
+// TTL is not part of the DescribeTable response, so this costs one extra API call per table.
+func readTableTTLStream(ctx context.Context, conn *dynamodb.Client, limiter *ratelimit.Limiter, d *schema.ResourceData) error {
+	table, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (*awstypes.TableDescription, error) {
+		return findTableByName(ctx, conn, d.Id())
+	})
+	if err != nil {
+		return err
+	}
+
+	ttl, err := findTTLByTableName(ctx, conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	setTableStream(d, table.StreamSpecification)
+
+	return d.Set("ttl", flattenTTL(&dynamodb.DescribeTimeToLiveOutput{
+		TimeToLiveDescription: ttl,
+	}))
+}
+
+// tableTTLStreamFromResourceData returns the Time to Live and stream settings set by resourceTableRead or readTableTTLStream.
+func tableTTLStreamFromResourceData(d *schema.ResourceData) (*awstypes.TimeToLiveDescription, *awstypes.StreamSpecification) {
+	ttl := &awstypes.TimeToLiveDescription{
+		TimeToLiveStatus: awstypes.TimeToLiveStatusDisabled,
+	}
+	if v, ok := d.Get("ttl").([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		if v, ok := tfMap["attribute_name"].(string); ok && v != "" {
+			ttl.AttributeName = aws.String(v)
+		}
+		if v, ok := tfMap[names.AttrEnabled].(bool); ok && v {
+			ttl.TimeToLiveStatus = awstypes.TimeToLiveStatusEnabled
+		}
+	}
+
+	stream := &awstypes.StreamSpecification{
+		StreamEnabled:  aws.Bool(d.Get("stream_enabled").(bool)),
+		StreamViewType: awstypes.StreamViewType(d.Get("stream_view_type").(string)),
+	}
+
+	return ttl, stream
+}
+
+// tableTTLStreamSummary describes a table's Time to Live attribute and stream view type, e.g. "ttl: expires_at, stream: NEW_IMAGE".
+func tableTTLStreamSummary(ttl *awstypes.TimeToLiveDescription, stream *awstypes.StreamSpecification) string {
+	ttlSummary := "disabled"
+	if ttl != nil {
+		switch status := ttl.TimeToLiveStatus; status {
+		case awstypes.TimeToLiveStatusEnabled:
+			ttlSummary = aws.ToString(ttl.AttributeName)
+		case awstypes.TimeToLiveStatusDisabled, "":
+			// TTL has never been configured or has been turned off.
+		default:
+			ttlSummary = fmt.Sprintf("%s (%s)", aws.ToString(ttl.AttributeName), strings.ToLower(string(status)))
+		}
+	}
+
+	streamSummary := "disabled"
+	if stream != nil && aws.ToBool(stream.StreamEnabled) {
+		streamSummary = string(stream.StreamViewType)
+	}
+
+	return fmt.Sprintf("ttl: %s, stream: %s", ttlSummary, streamSummary)
+}
+
+func newTableARN(ctx context.Context, c *conns.AWSClient, name string) string {
+	return c.RegionalARN(ctx, names.DynamoDB, "table/"+name)
+}
+
+// describeTableRateLimiters limit the DescribeTable calls made to hydrate listed tables.
+// DescribeTable is throttled aggressively, so tables are hydrated at no more than 10 per second by default.
+var describeTableRateLimiters = ratelimit.Register("dynamodb:DescribeTable", 10, 10)
@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	tfquerycheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/querycheck"
+	tfqueryfilter "github.com/hashicorp/terraform-provider-aws/internal/acctest/queryfilter"
+	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBTable_List_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resourceName1 := "aws_dynamodb_table.test[0]"
+	resourceName2 := "aws_dynamodb_table.test[1]"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	identity1 := tfstatecheck.Identity()
+	identity2 := tfstatecheck.Identity()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		CheckDestroy: testAccCheckTableDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Table/list_basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:  config.StringVariable(rName),
+					"resource_count": config.IntegerVariable(2),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					identity1.GetIdentity(resourceName1),
+					statecheck.ExpectKnownValue(resourceName1, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNExact("dynamodb", "table/"+rName+"-0")),
+
+					identity2.GetIdentity(resourceName2),
+					statecheck.ExpectKnownValue(resourceName2, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNExact("dynamodb", "table/"+rName+"-1")),
+				},
+			},
+
+			// Step 2: Query
+			{
+				Query:                    true,
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Table/list_basic/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:  config.StringVariable(rName),
+					"resource_count": config.IntegerVariable(2),
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					tfquerycheck.ExpectIdentityFunc("aws_dynamodb_table.test", identity1.Checks()),
+					querycheck.ExpectResourceDisplayName("aws_dynamodb_table.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), knownvalue.StringExact(rName+"-0")),
+					tfquerycheck.ExpectNoResourceObject("aws_dynamodb_table.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks())),
+
+					tfquerycheck.ExpectIdentityFunc("aws_dynamodb_table.test", identity2.Checks()),
+					querycheck.ExpectResourceDisplayName("aws_dynamodb_table.test", tfqueryfilter.ByResourceIdentityFunc(identity2.Checks()), knownvalue.StringExact(rName+"-1")),
+					tfquerycheck.ExpectNoResourceObject("aws_dynamodb_table.test", tfqueryfilter.ByResourceIdentityFunc(identity2.Checks())),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_List_includeResource(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resourceName1 := "aws_dynamodb_table.test[0]"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	identity1 := tfstatecheck.Identity()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		CheckDestroy: testAccCheckTableDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Table/list_include_resource/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:  config.StringVariable(rName),
+					"resource_count": config.IntegerVariable(1),
+					acctest.CtResourceTags: config.MapVariable(map[string]config.Variable{
+						acctest.CtKey1: config.StringVariable(acctest.CtValue1),
+					}),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					identity1.GetIdentity(resourceName1),
+					statecheck.ExpectKnownValue(resourceName1, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNExact("dynamodb", "table/"+rName+"-0")),
+				},
+			},
+
+			// Step 2: Query
+			{
+				Query:                    true,
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Table/list_include_resource/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:  config.StringVariable(rName),
+					"resource_count": config.IntegerVariable(1),
+					acctest.CtResourceTags: config.MapVariable(map[string]config.Variable{
+						acctest.CtKey1: config.StringVariable(acctest.CtValue1),
+					}),
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					tfquerycheck.ExpectIdentityFunc("aws_dynamodb_table.test", identity1.Checks()),
+					querycheck.ExpectResourceDisplayName("aws_dynamodb_table.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), knownvalue.StringExact(rName+"-0")),
+					querycheck.ExpectResourceKnownValues("aws_dynamodb_table.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), []querycheck.KnownValueCheck{
+						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNExact("dynamodb", "table/"+rName+"-0")),
+						tfquerycheck.KnownValueCheck(tfjsonpath.New("hash_key"), knownvalue.StringExact("TestTableHashKey")),
+						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName+"-0")),
+						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
+						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+							acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+						})),
+						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrTagsAll), knownvalue.MapExact(map[string]knownvalue.Check{
+							acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+						})),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_List_regionOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resourceName1 := "aws_dynamodb_table.test[0]"
+	resourceName2 := "aws_dynamodb_table.test[1]"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	identity1 := tfstatecheck.Identity()
+	identity2 := tfstatecheck.Identity()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		CheckDestroy: testAccCheckTableDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Table/list_region_override/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:  config.StringVariable(rName),
+					"resource_count": config.IntegerVariable(2),
+					"region":         config.StringVariable(acctest.AlternateRegion()),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					identity1.GetIdentity(resourceName1),
+					statecheck.ExpectKnownValue(resourceName1, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNAlternateRegionExact("dynamodb", "table/"+rName+"-0")),
+
+					identity2.GetIdentity(resourceName2),
+					statecheck.ExpectKnownValue(resourceName2, tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNAlternateRegionExact("dynamodb", "table/"+rName+"-1")),
+				},
+			},
+
+			// Step 2: Query
+			{
+				Query:                    true,
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Table/list_region_override/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName:  config.StringVariable(rName),
+					"resource_count": config.IntegerVariable(2),
+					"region":         config.StringVariable(acctest.AlternateRegion()),
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					tfquerycheck.ExpectIdentityFunc("aws_dynamodb_table.test", identity1.Checks()),
+
+					tfquerycheck.ExpectIdentityFunc("aws_dynamodb_table.test", identity2.Checks()),
+				},
+			},
+		},
+	})
+}
+
+func TestTableTTLStreamSummary(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ttl      *awstypes.TimeToLiveDescription
+		stream   *awstypes.StreamSpecification
+		expected string
+	}{
+		"ttl never configured": {
+			ttl: &awstypes.TimeToLiveDescription{
+				TimeToLiveStatus: awstypes.TimeToLiveStatusDisabled,
+			},
+			expected: "ttl: disabled, stream: disabled",
+		},
+		"ttl disabled": {
+			ttl: &awstypes.TimeToLiveDescription{
+				AttributeName:    aws.String("expires_at"),
+				TimeToLiveStatus: awstypes.TimeToLiveStatusDisabled,
+			},
+			expected: "ttl: disabled, stream: disabled",
+		},
+		"ttl enabled": {
+			ttl: &awstypes.TimeToLiveDescription{
+				AttributeName:    aws.String("expires_at"),
+				TimeToLiveStatus: awstypes.TimeToLiveStatusEnabled,
+			},
+			expected: "ttl: expires_at, stream: disabled",
+		},
+		"ttl enabling": {
+			ttl: &awstypes.TimeToLiveDescription{
+				AttributeName:    aws.String("expires_at"),
+				TimeToLiveStatus: awstypes.TimeToLiveStatusEnabling,
+			},
+			expected: "ttl: expires_at (enabling), stream: disabled",
+		},
+		"stream enabled": {
+			ttl: &awstypes.TimeToLiveDescription{
+				AttributeName:    aws.String("expires_at"),
+				TimeToLiveStatus: awstypes.TimeToLiveStatusEnabled,
+			},
+			stream: &awstypes.StreamSpecification{
+				StreamEnabled:  aws.Bool(true),
+				StreamViewType: awstypes.StreamViewTypeNewAndOldImages,
+			},
+			expected: "ttl: expires_at, stream: NEW_AND_OLD_IMAGES",
+		},
+		"stream disabled": {
+			stream: &awstypes.StreamSpecification{
+				StreamEnabled: aws.Bool(false),
+			},
+			expected: "ttl: disabled, stream: disabled",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfdynamodb.TableTTLStreamSummary(testCase.ttl, testCase.stream), testCase.expected; got != want {
+				t.Errorf("TableTTLStreamSummary() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTableTTLStreamFromResourceData(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ttl      *awstypes.TimeToLiveDescription
+		stream   *awstypes.StreamSpecification
+		expected string
+	}{
+		"ttl never configured": {
+			ttl: &awstypes.TimeToLiveDescription{
+				TimeToLiveStatus: awstypes.TimeToLiveStatusDisabled,
+			},
+			expected: "ttl: disabled, stream: disabled",
+		},
+		"ttl enabled": {
+			ttl: &awstypes.TimeToLiveDescription{
+				AttributeName:    aws.String("expires_at"),
+				TimeToLiveStatus: awstypes.TimeToLiveStatusEnabled,
+			},
+			expected: "ttl: expires_at, stream: disabled",
+		},
+		"stream enabled": {
+			ttl: &awstypes.TimeToLiveDescription{
+				TimeToLiveStatus: awstypes.TimeToLiveStatusDisabled,
+			},
+			stream: &awstypes.StreamSpecification{
+				StreamEnabled:  aws.Bool(true),
+				StreamViewType: awstypes.StreamViewTypeNewImage,
+			},
+			expected: "ttl: disabled, stream: NEW_IMAGE",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// The settings are set as the resource sets them, then read back for the display name.
+			d := schema.TestResourceDataRaw(t, tfdynamodb.ResourceTable().SchemaMap(), nil)
+			tfdynamodb.SetTableStream(d, testCase.stream)
+			if err := d.Set("ttl", tfdynamodb.FlattenTTL(&dynamodb.DescribeTimeToLiveOutput{TimeToLiveDescription: testCase.ttl})); err != nil {
+				t.Fatalf("setting ttl: %s", err)
+			}
+
+			ttl, stream := tfdynamodb.TableTTLStreamFromResourceData(d)
+			if got, want := tfdynamodb.TableTTLStreamSummary(ttl, stream), testCase.expected; got != want {
+				t.Errorf("TableTTLStreamSummary() = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -29,7 +29,12 @@ import (
 
 // @SDKResource("aws_ebs_snapshot", name="EBS Snapshot")
 // @Tags(identifierAttribute="id")
+// @IdentityAttribute("id")
+// @Testing(name="Snapshot")
 // @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Snapshot")
+// @Testing(preIdentityVersion="v6.34.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceEBSSnapshot() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEBSSnapshotCreate,
@@ -37,10 +42,6 @@ func resourceEBSSnapshot() *schema.Resource {
 		UpdateWithoutTimeout: resourceEBSSnapshotUpdate,
 		DeleteWithoutTimeout: resourceEBSSnapshotDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -187,7 +188,13 @@ func resourceEBSSnapshotRead(ctx context.Context, d *schema.ResourceData, meta a
 		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, ebsSnapshotARN(ctx, c, d.Id()))
+	resourceEBSSnapshotFlatten(ctx, c, snapshot, d)
+
+	return diags
+}
+
+func resourceEBSSnapshotFlatten(ctx context.Context, awsClient *conns.AWSClient, snapshot *awstypes.Snapshot, d *schema.ResourceData) {
+	d.Set(names.AttrARN, ebsSnapshotARN(ctx, awsClient, aws.ToString(snapshot.SnapshotId)))
 	d.Set("data_encryption_key_id", snapshot.DataEncryptionKeyId)
 	d.Set(names.AttrDescription, snapshot.Description)
 	d.Set(names.AttrEncrypted, snapshot.Encrypted)
@@ -200,8 +207,6 @@ func resourceEBSSnapshotRead(ctx context.Context, d *schema.ResourceData, meta a
 	d.Set(names.AttrVolumeSize, snapshot.VolumeSize)
 
 	setTagsOut(ctx, snapshot.Tags)
-
-	return diags
 }
 
 func resourceEBSSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
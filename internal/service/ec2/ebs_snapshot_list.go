@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_ebs_snapshot")
+func newEBSSnapshotResourceAsListResource() inttypes.ListResourceForSDK {
+	l := ebsSnapshotListResource{}
+	l.SetResourceSchema(resourceEBSSnapshot())
+
+	return &l
+}
+
+var _ list.ListResource = &ebsSnapshotListResource{}
+
+type ebsSnapshotListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type ebsSnapshotListResourceModel struct {
+	framework.WithRegionModel
+	VolumeID types.String `tfsdk:"volume_id"`
+}
+
+func (l *ebsSnapshotListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"volume_id": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *ebsSnapshotListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query ebsSnapshotListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	// Without an ownership filter DescribeSnapshots returns every snapshot the caller can restore,
+	// including all public snapshots in the Region.
+	input := ec2.DescribeSnapshotsInput{
+		OwnerIds: []string{"self"},
+	}
+	if v := query.VolumeID; !v.IsNull() && !v.IsUnknown() {
+		input.Filters = newAttributeFilterList(map[string]string{
+			"volume-id": v.ValueString(),
+		})
+	}
+	if request.Limit > 0 && request.Limit < describeSnapshotsMaxResults {
+		input.MaxResults = aws.Int32(int32(max(request.Limit, describeSnapshotsMinResults)))
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var count int64
+		for snapshot, err := range listSnapshots(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if request.Limit > 0 && count >= request.Limit {
+				return
+			}
+
+			id := aws.ToString(snapshot.SnapshotId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading resource")
+				resourceEBSSnapshotFlatten(ctx, awsClient, &snapshot, rd)
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%s)", id, aws.ToTime(snapshot.StartTime).Format(time.RFC3339))
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			count++
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+const (
+	// The bounds on the number of snapshots that DescribeSnapshots returns in a single page.
+	describeSnapshotsMinResults = 5
+	describeSnapshotsMaxResults = 1000
+)
+
+func listSnapshots(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSnapshotsInput) iter.Seq2[awstypes.Snapshot, error] {
+	return func(yield func(awstypes.Snapshot, error) bool) {
+		pages := ec2.NewDescribeSnapshotsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.Snapshot{}, fmt.Errorf("listing EBS Snapshots: %w", err))
+				return
+			}
+
+			for _, snapshot := range page.Snapshots {
+				if !yield(snapshot, nil) {
+					return
+				}
+			}
+		}
+	}
+}
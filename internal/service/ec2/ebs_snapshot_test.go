@@ -288,6 +288,10 @@ func testAccCheckEBSSnapshotDestroy(ctx context.Context) resource.TestCheckFunc
 	}
 }
 
+func testAccCheckSnapshotDestroy(ctx context.Context) resource.TestCheckFunc {
+	return testAccCheckEBSSnapshotDestroy(ctx)
+}
+
 func testAccEBSSnapshotBaseConfig(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_ebs_volume" "test" {
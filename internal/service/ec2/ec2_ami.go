@@ -41,7 +41,12 @@ const (
 
 // @SDKResource("aws_ami", name="AMI")
 // @Tags(identifierAttribute="id")
+// @IdentityAttribute("id")
 // @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Image")
+// @Testing(importIgnore="manage_ebs_snapshots")
+// @Testing(preIdentityVersion="v6.34.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceAMI() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAMICreate,
@@ -51,10 +56,6 @@ func resourceAMI() *schema.Resource {
 		UpdateWithoutTimeout: resourceAMIUpdate,
 		DeleteWithoutTimeout: resourceAMIDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(amiRetryTimeout),
 			Update: schema.DefaultTimeout(amiRetryTimeout),
@@ -433,8 +434,24 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		}
 	}
 
+	if err := resourceAMIFlatten(ctx, c, image, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := ec2.GetInstanceUefiDataInput{
+		InstanceId: aws.String(d.Id()),
+	}
+	instanceData, err := conn.GetInstanceUefiData(ctx, &input)
+	if err == nil {
+		d.Set("uefi_data", instanceData.UefiData)
+	}
+
+	return diags
+}
+
+func resourceAMIFlatten(ctx context.Context, awsClient *conns.AWSClient, image *awstypes.Image, d *schema.ResourceData) error {
 	d.Set("architecture", image.Architecture)
-	d.Set(names.AttrARN, amiARN(ctx, c, d.Id()))
+	d.Set(names.AttrARN, amiARN(ctx, awsClient, aws.ToString(image.ImageId)))
 	d.Set("boot_mode", image.BootMode)
 	d.Set(names.AttrDescription, image.Description)
 	d.Set("deprecation_time", image.DeprecationTime)
@@ -460,24 +477,16 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 	d.Set("virtualization_type", image.VirtualizationType)
 
 	if err := d.Set("ebs_block_device", flattenBlockDeviceMappingsForAMIEBSBlockDevice(image.BlockDeviceMappings)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ebs_block_device: %s", err)
+		return fmt.Errorf("setting ebs_block_device: %w", err)
 	}
 
 	if err := d.Set("ephemeral_block_device", flattenBlockDeviceMappingsForAMIEphemeralBlockDevice(image.BlockDeviceMappings)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ephemeral_block_device: %s", err)
-	}
-
-	input := ec2.GetInstanceUefiDataInput{
-		InstanceId: aws.String(d.Id()),
-	}
-	instanceData, err := conn.GetInstanceUefiData(ctx, &input)
-	if err == nil {
-		d.Set("uefi_data", instanceData.UefiData)
+		return fmt.Errorf("setting ephemeral_block_device: %w", err)
 	}
 
 	setTagsOut(ctx, image.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
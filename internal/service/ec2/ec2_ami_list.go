@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_ami")
+func newAMIResourceAsListResource() inttypes.ListResourceForSDK {
+	l := amiListResource{}
+	l.SetResourceSchema(resourceAMI())
+
+	return &l
+}
+
+var _ list.ListResource = &amiListResource{}
+
+type amiListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type amiListResourceModel struct {
+	framework.WithRegionModel
+	Owners fwtypes.ListValueOf[types.String] `tfsdk:"owners"`
+}
+
+func (l *amiListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"owners": listschema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (l *amiListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query amiListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	// Deprecated images are included so that they can still be managed; they are flagged in the display name.
+	input := ec2.DescribeImagesInput{
+		IncludeDeprecated: aws.Bool(true),
+	}
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// Without an ownership filter DescribeImages returns every public image in the Region.
+	if len(input.Owners) == 0 {
+		input.Owners = []string{"self"}
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for image, err := range listImages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			id := aws.ToString(image.ImageId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading resource")
+				if err := resourceAMIFlatten(ctx, awsClient, &image, rd); err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			result.DisplayName = amiDisplayName(&image, time.Now())
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// amiDisplayName returns the image name and creation date, flagging images that were deprecated before now.
+func amiDisplayName(image *awstypes.Image, now time.Time) string {
+	name, created := aws.ToString(image.Name), aws.ToString(image.CreationDate)
+
+	if v := aws.ToString(image.DeprecationTime); v != "" {
+		if t, err := time.Parse(time.RFC3339, v); err == nil && !t.After(now) {
+			return fmt.Sprintf("%s (%s, deprecated)", name, created)
+		}
+	}
+
+	return fmt.Sprintf("%s (%s)", name, created)
+}
+
+func listImages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeImagesInput) iter.Seq2[awstypes.Image, error] {
+	return func(yield func(awstypes.Image, error) bool) {
+		pages := ec2.NewDescribeImagesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.Image{}, fmt.Errorf("listing EC2 AMIs: %w", err))
+				return
+			}
+
+			for _, image := range page.Images {
+				if !yield(image, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -30,7 +30,11 @@ import (
 
 // @SDKResource("aws_eip", name="EIP")
 // @Tags(identifierAttribute="id")
+// @IdentityAttribute("id")
 // @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Address")
+// @Testing(preIdentityVersion="v6.34.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceEIP() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEIPCreate,
@@ -38,10 +42,6 @@ func resourceEIP() *schema.Resource {
 		UpdateWithoutTimeout: resourceEIPUpdate,
 		DeleteWithoutTimeout: resourceEIPDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Read:   schema.DefaultTimeout(15 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -227,9 +227,23 @@ func resourceEIPRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		return sdkdiag.AppendErrorf(diags, "reading EC2 EIP (%s): %s", d.Id(), err)
 	}
 
+	// Force ID to be an Allocation ID if we're on a VPC.
+	// This allows users to import the EIP based on the IP if they are in a VPC.
+	if address.Domain == awstypes.DomainTypeVpc && net.ParseIP(d.Id()) != nil {
+		d.SetId(aws.ToString(address.AllocationId))
+	}
+
+	if err := resourceEIPFlatten(ctx, meta.(*conns.AWSClient), address, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceEIPFlatten(ctx context.Context, awsClient *conns.AWSClient, address *awstypes.Address, d *schema.ResourceData) error {
 	allocationID := aws.ToString(address.AllocationId)
 	d.Set("allocation_id", allocationID)
-	d.Set(names.AttrARN, eipARN(ctx, meta.(*conns.AWSClient), allocationID))
+	d.Set(names.AttrARN, eipARN(ctx, awsClient, allocationID))
 	d.Set(names.AttrAssociationID, address.AssociationId)
 	d.Set("carrier_ip", address.CarrierIp)
 	d.Set("customer_owned_ip", address.CustomerOwnedIp)
@@ -244,20 +258,14 @@ func resourceEIPRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 	d.Set("public_ipv4_pool", address.PublicIpv4Pool)
 	d.Set("private_ip", address.PrivateIpAddress)
 	if v := aws.ToString(address.PrivateIpAddress); v != "" {
-		d.Set("private_dns", meta.(*conns.AWSClient).EC2PrivateDNSNameForIP(ctx, v))
+		d.Set("private_dns", awsClient.EC2PrivateDNSNameForIP(ctx, v))
 	}
 	d.Set("public_ip", address.PublicIp)
 	if v := aws.ToString(address.PublicIp); v != "" {
-		d.Set("public_dns", meta.(*conns.AWSClient).EC2PublicDNSNameForIP(ctx, v))
-	}
-
-	// Force ID to be an Allocation ID if we're on a VPC.
-	// This allows users to import the EIP based on the IP if they are in a VPC.
-	if address.Domain == awstypes.DomainTypeVpc && net.ParseIP(d.Id()) != nil {
-		d.SetId(aws.ToString(address.AllocationId))
+		d.Set("public_dns", awsClient.EC2PublicDNSNameForIP(ctx, v))
 	}
 
-	addressAttr, err := findEIPDomainNameAttributeByAllocationID(ctx, conn, d.Id())
+	addressAttr, err := findEIPDomainNameAttributeByAllocationID(ctx, awsClient.EC2Client(ctx), allocationID)
 
 	switch {
 	case err == nil:
@@ -267,12 +275,12 @@ func resourceEIPRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 	case tfawserr.ErrMessageContains(err, "InvalidAction", "not valid for this web service"):
 		d.Set("ptr_record", nil)
 	default:
-		return sdkdiag.AppendErrorf(diags, "reading EC2 EIP (%s) domain name attribute: %s", d.Id(), err)
+		return fmt.Errorf("reading EC2 EIP (%s) domain name attribute: %w", allocationID, err)
 	}
 
 	setTagsOut(ctx, address.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceEIPUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
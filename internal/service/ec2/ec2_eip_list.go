@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_eip")
+func newEIPResourceAsListResource() inttypes.ListResourceForSDK {
+	l := eipListResource{}
+	l.SetResourceSchema(resourceEIP())
+
+	return &l
+}
+
+var _ list.ListResource = &eipListResource{}
+
+type eipListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type eipListResourceModel struct {
+	framework.WithRegionModel
+	UnassociatedOnly types.Bool `tfsdk:"unassociated_only"`
+}
+
+func (l *eipListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"unassociated_only": listschema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *eipListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query eipListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	filter := eipUnassociatedOnlyFilter(query.UnassociatedOnly)
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input ec2.DescribeAddressesInput
+		for address, err := range listEIPs(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if !filter(&address) {
+				continue
+			}
+
+			allocationID := aws.ToString(address.AllocationId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), allocationID)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(allocationID)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading resource")
+				if err := resourceEIPFlatten(ctx, awsClient, &address, rd); err != nil {
+					tflog.Error(ctx, "Reading resource", map[string]any{
+						"error": err.Error(),
+					})
+					continue
+				}
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(address.PublicIp), allocationID)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// eipUnassociatedOnlyFilter returns a predicate selecting addresses that are not associated with an instance or network interface.
+// A null or false value selects all addresses.
+func eipUnassociatedOnlyFilter(unassociatedOnly types.Bool) tfslices.Predicate[*awstypes.Address] {
+	if !unassociatedOnly.ValueBool() {
+		return tfslices.PredicateTrue[*awstypes.Address]()
+	}
+
+	return func(v *awstypes.Address) bool {
+		return v.AssociationId == nil
+	}
+}
+
+// listEIPs returns all addresses in the Region. DescribeAddresses is not paginated.
+func listEIPs(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressesInput) iter.Seq2[awstypes.Address, error] {
+	return func(yield func(awstypes.Address, error) bool) {
+		output, err := conn.DescribeAddresses(ctx, input)
+		if err != nil {
+			yield(awstypes.Address{}, fmt.Errorf("listing EC2 EIPs: %w", err))
+			return
+		}
+
+		for _, address := range output.Addresses {
+			if !yield(address, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,34 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_security_group_rule" "test" {
+  count = var.resource_count
+
+  type              = "ingress"
+  protocol          = "tcp"
+  from_port         = 80 + count.index
+  to_port           = 80 + count.index
+  cidr_blocks       = ["10.0.0.0/8"]
+  security_group_id = aws_security_group.test.id
+}
+
+resource "aws_security_group" "test" {
+  name   = var.rName
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
@@ -7,6 +7,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 
@@ -30,7 +31,11 @@ import (
 
 // @SDKResource("aws_flow_log", name="Flow Log")
 // @Tags(identifierAttribute="id")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.FlowLog")
 // @Testing(tagsTest=false)
+// @Testing(preIdentityVersion="v6.34.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceFlowLog() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLogFlowCreate,
@@ -38,10 +43,6 @@ func resourceFlowLog() *schema.Resource {
 		UpdateWithoutTimeout: resourceLogFlowUpdate,
 		DeleteWithoutTimeout: resourceLogFlowDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -287,11 +288,21 @@ func resourceLogFlowRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "reading Flow Log (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, flowLogARN(ctx, c, d.Id()))
+	if err := resourceLogFlowFlatten(ctx, c, fl, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	setTagsOut(ctx, fl.Tags)
+
+	return diags
+}
+
+func resourceLogFlowFlatten(ctx context.Context, c *conns.AWSClient, fl *awstypes.FlowLog, d *schema.ResourceData) error {
+	d.Set(names.AttrARN, flowLogARN(ctx, c, aws.ToString(fl.FlowLogId)))
 	d.Set("deliver_cross_account_role", fl.DeliverCrossAccountRole)
 	if fl.DestinationOptions != nil {
 		if err := d.Set("destination_options", []any{flattenDestinationOptionsResponse(fl.DestinationOptions)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting destination_options: %s", err)
+			return fmt.Errorf("setting destination_options: %w", err)
 		}
 	} else {
 		d.Set("destination_options", nil)
@@ -321,9 +332,7 @@ func resourceLogFlowRead(ctx context.Context, d *schema.ResourceData, meta any)
 		d.Set("traffic_type", fl.TrafficType)
 	}
 
-	setTagsOut(ctx, fl.Tags)
-
-	return diags
+	return nil
 }
 
 func resourceLogFlowUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
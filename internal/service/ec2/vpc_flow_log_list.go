@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_flow_log")
+func newFlowLogResourceAsListResource() inttypes.ListResourceForSDK {
+	l := flowLogListResource{}
+	l.SetResourceSchema(resourceFlowLog())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &flowLogListResource{}
+
+type flowLogListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type flowLogListResourceModel struct {
+	framework.WithRegionModel
+	ResourceID types.String `tfsdk:"resource_id"`
+}
+
+func (l *flowLogListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrResourceID: listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *flowLogListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query flowLogListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	input := ec2.DescribeFlowLogsInput{
+		Filter: newAttributeFilterList(map[string]string{
+			"resource-id": query.ResourceID.ValueString(),
+		}),
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for flowLog, err := range listFlowLogs(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), aws.ToString(flowLog.FlowLogId))
+			result := request.NewListResult(ctx)
+			tags := keyValueTags(ctx, flowLog.Tags)
+			setTagsOut(ctx, flowLog.Tags)
+
+			rd := l.ResourceData()
+			rd.SetId(aws.ToString(flowLog.FlowLogId))
+
+			tflog.Info(ctx, "Reading resource")
+			if err := resourceLogFlowFlatten(ctx, awsClient, &flowLog, rd); err != nil {
+				tflog.Error(ctx, "Reading resource", map[string]any{
+					names.AttrID: aws.ToString(flowLog.FlowLogId),
+					"error":      err.Error(),
+				})
+				continue
+			}
+
+			if v, ok := tags["Name"]; ok {
+				result.DisplayName = fmt.Sprintf("%s (%s)", v.ValueString(), aws.ToString(flowLog.FlowLogId))
+			} else {
+				result.DisplayName = aws.ToString(flowLog.FlowLogId)
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listFlowLogs(ctx context.Context, conn *ec2.Client, input *ec2.DescribeFlowLogsInput) iter.Seq2[awstypes.FlowLog, error] {
+	return func(yield func(awstypes.FlowLog, error) bool) {
+		pages := ec2.NewDescribeFlowLogsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.FlowLog{}, fmt.Errorf("listing EC2 Flow Logs: %w", err))
+				return
+			}
+
+			for _, flowLog := range page.FlowLogs {
+				if !yield(flowLog, nil) {
+					return
+				}
+			}
+		}
+	}
+}
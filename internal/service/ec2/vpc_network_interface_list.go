@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_network_interface")
+func newNetworkInterfaceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := networkInterfaceListResource{}
+	l.SetResourceSchema(resourceNetworkInterface())
+
+	return &l
+}
+
+var _ list.ListResource = &networkInterfaceListResource{}
+
+type networkInterfaceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type networkInterfaceListResourceModel struct {
+	framework.WithRegionModel
+	Status types.String `tfsdk:"status"`
+}
+
+func (l *networkInterfaceListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrStatus: listschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.NetworkInterfaceStatusAvailable, awstypes.NetworkInterfaceStatusInUse)...),
+				},
+			},
+		},
+	}
+}
+
+func (l *networkInterfaceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query networkInterfaceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeNetworkInterfacesInput
+	if v := query.Status; !v.IsNull() && !v.IsUnknown() {
+		input.Filters = newAttributeFilterList(map[string]string{
+			names.AttrStatus: v.ValueString(),
+		})
+	}
+	if request.Limit > 0 && request.Limit < describeNetworkInterfacesMaxResults {
+		input.MaxResults = aws.Int32(int32(max(request.Limit, describeNetworkInterfacesMinResults)))
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var count int64
+		for eni, err := range listNetworkInterfaces(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if request.Limit > 0 && count >= request.Limit {
+				return
+			}
+
+			id := aws.ToString(eni.NetworkInterfaceId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading resource")
+				if err := resourceNetworkInterfaceFlatten(ctx, awsClient, &eni, rd); err != nil {
+					tflog.Error(ctx, "Reading resource", map[string]any{
+						"error": err.Error(),
+					})
+					continue
+				}
+			}
+
+			if v := aws.ToString(eni.Description); v != "" {
+				result.DisplayName = fmt.Sprintf("%s (%s)", id, v)
+			} else {
+				result.DisplayName = id
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			count++
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+const (
+	// The bounds on the number of network interfaces that DescribeNetworkInterfaces returns in a single page.
+	describeNetworkInterfacesMinResults = 5
+	describeNetworkInterfacesMaxResults = 1000
+)
+
+func listNetworkInterfaces(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInterfacesInput) iter.Seq2[awstypes.NetworkInterface, error] {
+	return func(yield func(awstypes.NetworkInterface, error) bool) {
+		pages := ec2.NewDescribeNetworkInterfacesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.NetworkInterface{}, fmt.Errorf("listing EC2 Network Interfaces: %w", err))
+				return
+			}
+
+			for _, eni := range page.NetworkInterfaces {
+				if !yield(eni, nil) {
+					return
+				}
+			}
+		}
+	}
+}
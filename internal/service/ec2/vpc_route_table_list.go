@@ -43,6 +43,7 @@ type routeTableListResource struct {
 type routeTableListResourceModel struct {
 	framework.WithRegionModel
 	RouteTableIDs fwtypes.ListValueOf[types.String] `tfsdk:"route_table_ids"`
+	VPCID         types.String                      `tfsdk:"vpc_id" autoflex:"-"`
 	Filters       customListFilters                 `tfsdk:"filter"`
 }
 
@@ -54,6 +55,9 @@ func (l *routeTableListResource) ListResourceConfigSchema(ctx context.Context, _
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			names.AttrVPCID: listschema.StringAttribute{
+				Optional: true,
+			},
 		},
 		Blocks: map[string]listschema.Block{
 			names.AttrFilter: listschema.ListNestedBlock{
@@ -100,6 +104,13 @@ func (l *routeTableListResource) List(ctx context.Context, request list.ListRequ
 		return
 	}
 
+	if v := query.VPCID; !v.IsNull() && !v.IsUnknown() {
+		input.Filters = append(input.Filters, awstypes.Filter{
+			Name:   aws.String("vpc-id"),
+			Values: []string{v.ValueString()},
+		})
+	}
+
 	tflog.Info(ctx, "Listing resources")
 
 	stream.Results = func(yield func(list.ListResult) bool) {
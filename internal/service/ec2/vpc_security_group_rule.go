@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -36,6 +37,9 @@ import (
 )
 
 // @SDKResource("aws_security_group_rule", name="Security Group Rule")
+// @IdentityAttribute("security_group_rule_id")
+// @CustomImport
+// @Testing(identityTest=false)
 func resourceSecurityGroupRule() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -431,7 +435,28 @@ func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
-func resourceSecurityGroupRuleImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+func resourceSecurityGroupRuleImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		// Import by resource identity, which holds the AWS security group rule ID.
+		if err := importer.Import(ctx, d, meta); err != nil {
+			return nil, err
+		}
+
+		conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+		rule, err := findSecurityGroupRuleByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return nil, fmt.Errorf("reading Security Group Rule (%s): %w", d.Id(), err)
+		}
+
+		if err := resourceSecurityGroupRuleFlatten(ctx, rule, d); err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+
 	invalidIDError := func(msg string) error {
 		return fmt.Errorf("unexpected format for ID (%q), expected SECURITYGROUPID_TYPE_PROTOCOL_FROMPORT_TOPORT_SOURCE[_SOURCE]*: %s", d.Id(), msg)
 	}
@@ -528,6 +553,40 @@ func resourceSecurityGroupRuleImport(_ context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceSecurityGroupRuleFlatten sets the resource's ID and attributes from an AWS security group rule.
+// AWS security group rules have a single source or destination, so the resource matches exactly one of them.
+func resourceSecurityGroupRuleFlatten(_ context.Context, apiObject *awstypes.SecurityGroupRule, d *schema.ResourceData) error {
+	securityGroupID := aws.ToString(apiObject.GroupId)
+	ruleType := securityGroupRuleTypeIngress
+	if aws.ToBool(apiObject.IsEgress) {
+		ruleType = securityGroupRuleTypeEgress
+	}
+	ipPermission := ipPermissionFromSecurityGroupRule(apiObject)
+
+	id, err := securityGroupRuleCreateID(securityGroupID, string(ruleType), &ipPermission)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrDescription, apiObject.Description)
+	flattenIpPermission(d, &ipPermission)
+	d.Set("security_group_id", securityGroupID)
+	d.Set("security_group_rule_id", apiObject.SecurityGroupRuleId)
+	self := false
+	if v := apiObject.ReferencedGroupInfo; v != nil {
+		if groupID := aws.ToString(v.GroupId); groupID == securityGroupID {
+			self = true
+		} else {
+			d.Set("source_security_group_id", groupID)
+		}
+	}
+	d.Set("self", self)
+	d.Set(names.AttrType, ruleType)
+
+	return nil
+}
+
 func findRuleMatch(p awstypes.IpPermission, rules []awstypes.IpPermission) (*awstypes.IpPermission, *string) {
 	var rule *awstypes.IpPermission
 	var description *string
@@ -767,6 +826,39 @@ func securityGroupRuleCreateID(securityGroupID, ruleType string, ip *awstypes.Ip
 	return fmt.Sprintf("sgrule-%d", create.StringHashcode(buf.String())), nil
 }
 
+func ipPermissionFromSecurityGroupRule(apiObject *awstypes.SecurityGroupRule) awstypes.IpPermission {
+	ipPermission := awstypes.IpPermission{
+		IpProtocol: aws.String(protocolForValue(aws.ToString(apiObject.IpProtocol))),
+	}
+
+	// InvalidParameterValue: When protocol is ALL, you cannot specify from-port.
+	if v := aws.ToString(ipPermission.IpProtocol); v != "-1" {
+		ipPermission.FromPort = apiObject.FromPort
+		ipPermission.ToPort = apiObject.ToPort
+	}
+
+	switch {
+	case apiObject.CidrIpv4 != nil:
+		ipPermission.IpRanges = []awstypes.IpRange{{
+			CidrIp: apiObject.CidrIpv4,
+		}}
+	case apiObject.CidrIpv6 != nil:
+		ipPermission.Ipv6Ranges = []awstypes.Ipv6Range{{
+			CidrIpv6: apiObject.CidrIpv6,
+		}}
+	case apiObject.PrefixListId != nil:
+		ipPermission.PrefixListIds = []awstypes.PrefixListId{{
+			PrefixListId: apiObject.PrefixListId,
+		}}
+	case apiObject.ReferencedGroupInfo != nil:
+		ipPermission.UserIdGroupPairs = []awstypes.UserIdGroupPair{{
+			GroupId: apiObject.ReferencedGroupInfo.GroupId,
+		}}
+	}
+
+	return ipPermission
+}
+
 func expandIPPermission(d *schema.ResourceData, sg *awstypes.SecurityGroup) awstypes.IpPermission { // nosemgrep:ci.caps5-in-func-name
 	apiObject := awstypes.IpPermission{
 		IpProtocol: aws.String(protocolForValue(d.Get(names.AttrProtocol).(string))),
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKListResource("aws_security_group_rule")
+func newSecurityGroupRuleResourceAsListResource() inttypes.ListResourceForSDK {
+	l := securityGroupRuleListResource{}
+	l.SetResourceSchema(resourceSecurityGroupRule())
+
+	return &l
+}
+
+var _ list.ListResource = &securityGroupRuleListResource{}
+
+type securityGroupRuleListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type securityGroupRuleListResourceModel struct {
+	framework.WithRegionModel
+	GroupID types.String `tfsdk:"group_id"`
+}
+
+func (l *securityGroupRuleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"group_id": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *securityGroupRuleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	var query securityGroupRuleListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeSecurityGroupRulesInput
+	if v := query.GroupID.ValueString(); v != "" {
+		input.Filters = newAttributeFilterList(map[string]string{
+			"group-id": v,
+		})
+	}
+
+	tflog.Info(ctx, "Listing Security Group Rules")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for rule, err := range listSecurityGroupRules(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ruleID := aws.ToString(rule.SecurityGroupRuleId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("security_group_rule_id"), ruleID)
+
+			result := request.NewListResult(ctx)
+
+			// DescribeSecurityGroupRules returns the full rule inline.
+			// aws_security_group_rule has no tags, so the rule's TagSet is not used.
+			rd := l.ResourceData()
+			if err := resourceSecurityGroupRuleFlatten(ctx, &rule, rd); err != nil {
+				tflog.Error(ctx, "Reading Security Group Rule", map[string]any{
+					"error": err.Error(),
+				})
+				continue
+			}
+
+			result.DisplayName = securityGroupRuleDisplayName(&rule)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// securityGroupRuleDisplayName returns the rule's direction, protocol and port range, e.g. "ingress tcp 80-8000".
+func securityGroupRuleDisplayName(apiObject *awstypes.SecurityGroupRule) string {
+	ruleType := securityGroupRuleTypeIngress
+	if aws.ToBool(apiObject.IsEgress) {
+		ruleType = securityGroupRuleTypeEgress
+	}
+
+	protocol := protocolForValue(aws.ToString(apiObject.IpProtocol))
+	if protocol == "-1" {
+		return fmt.Sprintf("%s all", ruleType)
+	}
+
+	fromPort, toPort := aws.ToInt32(apiObject.FromPort), aws.ToInt32(apiObject.ToPort)
+	if fromPort == toPort {
+		return fmt.Sprintf("%s %s %d", ruleType, protocol, fromPort)
+	}
+
+	return fmt.Sprintf("%s %s %d-%d", ruleType, protocol, fromPort, toPort)
+}
+
+func listSecurityGroupRules(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSecurityGroupRulesInput) iter.Seq2[awstypes.SecurityGroupRule, error] {
+	return func(yield func(awstypes.SecurityGroupRule, error) bool) {
+		pages := ec2.NewDescribeSecurityGroupRulesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.SecurityGroupRule{}, fmt.Errorf("listing Security Group Rules: %w", err))
+				return
+			}
+
+			for _, rule := range page.SecurityGroupRules {
+				if !yield(rule, nil) {
+					return
+				}
+			}
+		}
+	}
+}
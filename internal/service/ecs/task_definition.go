@@ -734,6 +734,23 @@ func findTaskDefinition(ctx context.Context, conn *ecs.Client, input *ecs.Descri
 }
 
 func findTaskDefinitionByFamilyOrARN(ctx context.Context, conn *ecs.Client, familyOrARN string) (*awstypes.TaskDefinition, []awstypes.Tag, error) {
+	taskDefinition, tags, err := findTaskDefinitionWithTagsByFamilyOrARN(ctx, conn, familyOrARN)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if status := taskDefinition.Status; status == awstypes.TaskDefinitionStatusInactive || status == awstypes.TaskDefinitionStatusDeleteInProgress {
+		return nil, nil, &retry.NotFoundError{
+			Message: string(status),
+		}
+	}
+
+	return taskDefinition, tags, nil
+}
+
+// findTaskDefinitionWithTagsByFamilyOrARN returns the task definition and its tags regardless of the task definition's status.
+func findTaskDefinitionWithTagsByFamilyOrARN(ctx context.Context, conn *ecs.Client, familyOrARN string) (*awstypes.TaskDefinition, []awstypes.Tag, error) {
 	input := &ecs.DescribeTaskDefinitionInput{
 		Include:        []awstypes.TaskDefinitionField{awstypes.TaskDefinitionFieldTags},
 		TaskDefinition: aws.String(familyOrARN),
@@ -748,17 +765,7 @@ func findTaskDefinitionByFamilyOrARN(ctx context.Context, conn *ecs.Client, fami
 		taskDefinition, tags, err = findTaskDefinition(ctx, conn, input)
 	}
 
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if status := taskDefinition.Status; status == awstypes.TaskDefinitionStatusInactive || status == awstypes.TaskDefinitionStatusDeleteInProgress {
-		return nil, nil, &retry.NotFoundError{
-			Message: string(status),
-		}
-	}
-
-	return taskDefinition, tags, nil
+	return taskDefinition, tags, err
 }
 
 func validTaskDefinitionContainerDefinitions(v any, k string) (ws []string, errors []error) {
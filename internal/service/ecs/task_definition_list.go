@@ -7,14 +7,20 @@ import (
 	"context"
 	"fmt"
 	"iter"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -32,6 +38,19 @@ type listResourceTaskDefinition struct {
 	framework.ListResourceWithSDKv2Resource
 }
 
+func (l *listResourceTaskDefinition) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"active_only": listschema.BoolAttribute{
+				Optional: true,
+			},
+			"family_prefix": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
 func (l *listResourceTaskDefinition) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
 	conn := l.Meta().ECSClient(ctx)
 
@@ -43,56 +62,93 @@ func (l *listResourceTaskDefinition) List(ctx context.Context, request list.List
 		}
 	}
 
+	// ListTaskDefinitions returns task definitions of a single status, so inactive revisions are listed separately.
+	statuses := []awstypes.TaskDefinitionStatus{awstypes.TaskDefinitionStatusActive}
+	if v := query.ActiveOnly; !v.IsNull() && !v.ValueBool() {
+		statuses = append(statuses, awstypes.TaskDefinitionStatusInactive)
+	}
+
 	tflog.Info(ctx, "Listing ECS (Elastic Container) Task Definition")
 	stream.Results = func(yield func(list.ListResult) bool) {
-		var input ecs.ListTaskDefinitionsInput
-		for arnStr, err := range listTaskDefinitions(ctx, conn, &input) {
-			if err != nil {
-				result := fwdiag.NewListResultErrorDiagnostic(err)
-				yield(result)
-				return
-			}
+		limiter := describeTaskDefinitionRateLimiters.For(l.Meta().RateLimitScope(ctx))
 
-			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arnStr)
-
-			result := request.NewListResult(ctx)
-			rd := l.ResourceData()
-			rd.SetId(arnStr)
-			rd.Set(names.AttrARN, arnStr)
-
-			taskDefinition, tags, err := findTaskDefinitionByFamilyOrARN(ctx, conn, arnStr)
-			if err != nil {
-				tflog.Error(ctx, "Reading ECS (Elastic Container) Task Definition", map[string]any{
-					names.AttrARN: arnStr,
-					"err":         err.Error(),
-				})
-				continue
+		for _, status := range statuses {
+			input := ecs.ListTaskDefinitionsInput{
+				FamilyPrefix: fwflex.StringFromFramework(ctx, query.FamilyPrefix),
+				Status:       status,
 			}
+			for arnStr, err := range listTaskDefinitions(ctx, conn, &input) {
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
 
-			tflog.Info(ctx, "Reading ECS (Elastic Container) Task Definition")
-			diags := resourceTaskDefinitionFlatten(ctx, rd, taskDefinition, tags)
-			if diags.HasError() {
-				tflog.Error(ctx, "Reading ECS (Elastic Container) Task Definition", map[string]any{
-					"diags": sdkdiag.DiagnosticsString(diags),
-				})
-				continue
-			}
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arnStr)
 
-			if rd.Id() == "" {
-				tflog.Warn(ctx, "Resource disappeared during listing, skipping")
-				continue
-			}
+				family, revision, err := parseRevisionParts(arnStr)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+				rev, err := strconv.Atoi(revision)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
 
-			result.DisplayName = rd.Get(names.AttrFamily).(string)
+				result := request.NewListResult(ctx)
+				rd := l.ResourceData()
+				rd.SetId(family)
+				rd.Set(names.AttrARN, arnStr)
+				rd.Set(names.AttrFamily, family)
+				rd.Set("revision", rev)
+
+				if request.IncludeResource {
+					// Task definitions aren't read once listing has been canceled.
+					if err := ctx.Err(); err != nil {
+						result := fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+
+					var tags []awstypes.Tag
+					taskDefinition, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (*awstypes.TaskDefinition, error) {
+						taskDefinition, v, err := findTaskDefinitionWithTagsByFamilyOrARN(ctx, conn, arnStr)
+						tags = v
+						return taskDefinition, err
+					})
+					if err != nil {
+						tflog.Error(ctx, "Reading ECS (Elastic Container) Task Definition", map[string]any{
+							names.AttrARN: arnStr,
+							"err":         err.Error(),
+						})
+						continue
+					}
+
+					tflog.Info(ctx, "Reading ECS (Elastic Container) Task Definition")
+					diags := resourceTaskDefinitionFlatten(ctx, rd, taskDefinition, tags)
+					if diags.HasError() {
+						tflog.Error(ctx, "Reading ECS (Elastic Container) Task Definition", map[string]any{
+							"diags": sdkdiag.DiagnosticsString(diags),
+						})
+						continue
+					}
+				}
 
-			l.SetResult(ctx, l.Meta(), request.IncludeResource, &result, rd)
-			if result.Diagnostics.HasError() {
-				yield(result)
-				return
-			}
+				result.DisplayName = familyAndRevisionFromTaskDefinitionARN(arnStr)
 
-			if !yield(result) {
-				return
+				l.SetResult(ctx, l.Meta(), request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
 			}
 		}
 	}
@@ -100,6 +156,8 @@ func (l *listResourceTaskDefinition) List(ctx context.Context, request list.List
 
 type listTaskDefinitionModel struct {
 	framework.WithRegionModel
+	ActiveOnly   types.Bool   `tfsdk:"active_only"`
+	FamilyPrefix types.String `tfsdk:"family_prefix"`
 }
 
 func listTaskDefinitions(ctx context.Context, conn *ecs.Client, input *ecs.ListTaskDefinitionsInput) iter.Seq2[string, error] {
@@ -120,3 +178,6 @@ func listTaskDefinitions(ctx context.Context, conn *ecs.Client, input *ecs.ListT
 		}
 	}
 }
+
+// describeTaskDefinitionRateLimiters limit the DescribeTaskDefinition calls made to hydrate listed task definitions, to 10 per second by default.
+var describeTaskDefinitionRateLimiters = ratelimit.Register("ecs:DescribeTaskDefinition", 10, 10)
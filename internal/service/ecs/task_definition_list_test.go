@@ -69,11 +69,11 @@ func TestAccECSTaskDefinition_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					tfquerycheck.ExpectIdentityFunc("aws_ecs_task_definition.test", identity1.Checks()),
-					querycheck.ExpectResourceDisplayName("aws_ecs_task_definition.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), knownvalue.StringExact(rName+"-0")),
+					querycheck.ExpectResourceDisplayName("aws_ecs_task_definition.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), knownvalue.StringExact(rName+"-0:1")),
 					tfquerycheck.ExpectNoResourceObject("aws_ecs_task_definition.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks())),
 
 					tfquerycheck.ExpectIdentityFunc("aws_ecs_task_definition.test", identity2.Checks()),
-					querycheck.ExpectResourceDisplayName("aws_ecs_task_definition.test", tfqueryfilter.ByResourceIdentityFunc(identity2.Checks()), knownvalue.StringExact(rName+"-1")),
+					querycheck.ExpectResourceDisplayName("aws_ecs_task_definition.test", tfqueryfilter.ByResourceIdentityFunc(identity2.Checks()), knownvalue.StringExact(rName+"-1:1")),
 					tfquerycheck.ExpectNoResourceObject("aws_ecs_task_definition.test", tfqueryfilter.ByResourceIdentityFunc(identity2.Checks())),
 				},
 			},
@@ -130,7 +130,7 @@ func TestAccECSTaskDefinition_List_includeResource(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					tfquerycheck.ExpectIdentityFunc("aws_ecs_task_definition.test", identity1.Checks()),
-					querycheck.ExpectResourceDisplayName("aws_ecs_task_definition.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), knownvalue.StringExact(rName+"-0")),
+					querycheck.ExpectResourceDisplayName("aws_ecs_task_definition.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), knownvalue.StringExact(rName+"-0:1")),
 					querycheck.ExpectResourceKnownValues("aws_ecs_task_definition.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), []querycheck.KnownValueCheck{
 						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNExact("ecs", "task-definition/"+rName+"-0:1")),
 						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
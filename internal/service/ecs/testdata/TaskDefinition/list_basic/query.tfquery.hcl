@@ -3,4 +3,8 @@
 
 list "aws_ecs_task_definition" "test" {
   provider = aws
+
+  config {
+    family_prefix = var.rName
+  }
 }
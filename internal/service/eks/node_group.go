@@ -30,12 +30,19 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_eks_node_group", name="Node Group")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("cluster_name")
+// @IdentityAttribute("node_group_name")
+// @ImportIDHandler("nodeGroupImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/eks/types;types.Nodegroup")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceNodeGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNodeGroupCreate,
@@ -43,10 +50,6 @@ func resourceNodeGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceNodeGroupUpdate,
 		DeleteWithoutTimeout: resourceNodeGroupDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -484,6 +487,14 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta any
 		return sdkdiag.AppendErrorf(diags, "reading EKS Node Group (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceNodeGroupFlatten(ctx, nodeGroup, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceNodeGroupFlatten(ctx context.Context, nodeGroup *types.Nodegroup, d *schema.ResourceData) error {
 	d.Set("ami_type", nodeGroup.AmiType)
 	d.Set(names.AttrARN, nodeGroup.NodegroupArn)
 	d.Set("capacity_type", nodeGroup.CapacityType)
@@ -492,13 +503,13 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta any
 	d.Set("instance_types", nodeGroup.InstanceTypes)
 	d.Set("labels", nodeGroup.Labels)
 	if err := d.Set(names.AttrLaunchTemplate, flattenLaunchTemplateSpecification(nodeGroup.LaunchTemplate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting launch_template: %s", err)
+		return fmt.Errorf("setting launch_template: %w", err)
 	}
 	d.Set("node_group_name", nodeGroup.NodegroupName)
 	d.Set("node_group_name_prefix", create.NamePrefixFromName(aws.ToString(nodeGroup.NodegroupName)))
 	if nodeGroup.NodeRepairConfig != nil {
 		if err := d.Set("node_repair_config", []any{flattenNodeRepairConfig(nodeGroup.NodeRepairConfig)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting node_repair_config: %s", err)
+			return fmt.Errorf("setting node_repair_config: %w", err)
 		}
 	} else {
 		d.Set("node_repair_config", nil)
@@ -506,14 +517,14 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta any
 	d.Set("node_role_arn", nodeGroup.NodeRole)
 	d.Set("release_version", nodeGroup.ReleaseVersion)
 	if err := d.Set("remote_access", flattenRemoteAccessConfig(nodeGroup.RemoteAccess)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting remote_access: %s", err)
+		return fmt.Errorf("setting remote_access: %w", err)
 	}
 	if err := d.Set(names.AttrResources, flattenNodegroupResources(nodeGroup.Resources)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting resources: %s", err)
+		return fmt.Errorf("setting resources: %w", err)
 	}
 	if nodeGroup.ScalingConfig != nil {
 		if err := d.Set("scaling_config", []any{flattenNodegroupScalingConfig(nodeGroup.ScalingConfig)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting scaling_config: %s", err)
+			return fmt.Errorf("setting scaling_config: %w", err)
 		}
 	} else {
 		d.Set("scaling_config", nil)
@@ -521,11 +532,11 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta any
 	d.Set(names.AttrStatus, nodeGroup.Status)
 	d.Set(names.AttrSubnetIDs, nodeGroup.Subnets)
 	if err := d.Set("taint", flattenTaints(nodeGroup.Taints)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting taint: %s", err)
+		return fmt.Errorf("setting taint: %w", err)
 	}
 	if nodeGroup.UpdateConfig != nil {
 		if err := d.Set("update_config", []any{flattenNodegroupUpdateConfig(nodeGroup.UpdateConfig)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting update_config: %s", err)
+			return fmt.Errorf("setting update_config: %w", err)
 		}
 	} else {
 		d.Set("update_config", nil)
@@ -534,7 +545,7 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta any
 
 	setTagsOut(ctx, nodeGroup.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -1288,3 +1299,25 @@ func flattenTaints(apiObjects []types.Taint) []any {
 
 	return tfList
 }
+
+var _ inttypes.SDKv2ImportID = nodeGroupImportID{}
+
+type nodeGroupImportID struct{}
+
+func (nodeGroupImportID) Create(d *schema.ResourceData) string {
+	return NodeGroupCreateResourceID(d.Get(names.AttrClusterName).(string), d.Get("node_group_name").(string))
+}
+
+func (nodeGroupImportID) Parse(id string) (string, map[string]any, error) {
+	clusterName, nodeGroupName, err := NodeGroupParseResourceID(id)
+	if err != nil {
+		return id, nil, err
+	}
+
+	result := map[string]any{
+		names.AttrClusterName: clusterName,
+		"node_group_name":     nodeGroupName,
+	}
+
+	return id, result, nil
+}
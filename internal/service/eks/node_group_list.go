@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_eks_node_group")
+func newNodeGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := nodeGroupListResource{}
+	l.SetResourceSchema(resourceNodeGroup())
+
+	return &l
+}
+
+var _ list.ListResource = &nodeGroupListResource{}
+
+type nodeGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type nodeGroupListResourceModel struct {
+	framework.WithRegionModel
+	ClusterName types.String `tfsdk:"cluster_name"`
+}
+
+func (l *nodeGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrClusterName: listschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (l *nodeGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EKSClient(ctx)
+
+	var query nodeGroupListResourceModel
+	if diags := request.Config.Get(ctx, &query); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	clusterName := query.ClusterName.ValueString()
+
+	tflog.Info(ctx, "Listing EKS Node Groups", map[string]any{
+		names.AttrClusterName: clusterName,
+	})
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := eks.ListNodegroupsInput{
+			ClusterName: aws.String(clusterName),
+		}
+		if request.Limit > 0 && request.Limit < listNodegroupsMaxResults {
+			input.MaxResults = aws.Int32(int32(request.Limit))
+		}
+
+		var count int64
+		for nodeGroupName, err := range listNodegroups(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if request.Limit > 0 && count >= request.Limit {
+				return
+			}
+
+			id := NodeGroupCreateResourceID(clusterName, nodeGroupName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+			rd.Set(names.AttrClusterName, clusterName)
+			rd.Set("node_group_name", nodeGroupName)
+
+			// ListNodegroups returns only names, so each node group is described to determine its instance types.
+			tflog.Info(ctx, "Reading EKS Node Group")
+			nodeGroup, err := findNodegroupByTwoPartKey(ctx, conn, clusterName, nodeGroupName)
+			if err != nil {
+				tflog.Error(ctx, "Reading EKS Node Group", map[string]any{
+					"error": err.Error(),
+				})
+				continue
+			}
+
+			if request.IncludeResource {
+				if err := resourceNodeGroupFlatten(ctx, nodeGroup, rd); err != nil {
+					tflog.Error(ctx, "Reading EKS Node Group", map[string]any{
+						"error": err.Error(),
+					})
+					continue
+				}
+			}
+
+			result.DisplayName = nodeGroupName
+			if v := nodeGroup.InstanceTypes; len(v) > 0 {
+				result.DisplayName = fmt.Sprintf("%s (%s)", nodeGroupName, strings.Join(v, ", "))
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			count++
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+const (
+	// The maximum number of node groups that ListNodegroups returns in a single page.
+	listNodegroupsMaxResults = 100
+)
+
+func listNodegroups(ctx context.Context, conn *eks.Client, input *eks.ListNodegroupsInput) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		pages := eks.NewListNodegroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield("", fmt.Errorf("listing EKS Node Groups (%s): %w", aws.ToString(input.ClusterName), err))
+				return
+			}
+
+			for _, nodeGroupName := range page.Nodegroups {
+				if !yield(nodeGroupName, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,12 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_eks_node_group" "test" {
+  provider = aws
+
+  config {
+    cluster_name = aws_eks_cluster.test.name
+  }
+
+  include_resource = true
+}
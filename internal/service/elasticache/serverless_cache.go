@@ -42,6 +42,9 @@ import (
 
 // @FrameworkResource("aws_elasticache_serverless_cache", name="Serverless Cache")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/elasticache/types;awstypes;awstypes.ServerlessCache")
+// @Testing(preIdentityVersion="v6.34.0")
 func newServerlessCacheResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &serverlessCacheResource{}
 
@@ -54,7 +57,7 @@ func newServerlessCacheResource(context.Context) (resource.ResourceWithConfigure
 
 type serverlessCacheResource struct {
 	framework.ResourceWithModel[serverlessCacheResourceModel]
-	framework.WithImportByID
+	framework.WithImportByIdentity
 	framework.WithTimeouts
 }
 
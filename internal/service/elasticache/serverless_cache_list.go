@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
+// @FrameworkListResource("aws_elasticache_serverless_cache")
+func newServerlessCacheResourceAsListResource() list.ListResourceWithConfigure {
+	return &serverlessCacheListResource{}
+}
+
+var _ list.ListResource = &serverlessCacheListResource{}
+
+type serverlessCacheListResource struct {
+	serverlessCacheResource
+	framework.WithList
+}
+
+func (r *serverlessCacheListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query listServerlessCacheModel
+
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := r.Meta()
+	conn := awsClient.ElastiCacheClient(ctx)
+
+	tflog.Info(ctx, "Listing ElastiCache Serverless Caches")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input elasticache.DescribeServerlessCachesInput
+		for page, err := range listServerlessCachePages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Tags are fetched for the whole page in as few Resource Groups Tagging API calls as possible
+			// rather than with one ListTagsForResource call per cache.
+			var tags map[string]map[string]string
+			if request.IncludeResource {
+				arns := make([]string, 0, len(page))
+				for _, cache := range page {
+					arns = append(arns, aws.ToString(cache.ARN))
+				}
+
+				tags, err = tftags.BatchFetchResourceTags(ctx, awsClient.ResourceGroupsTaggingAPIClient(ctx), awsClient.RateLimitScope(ctx), arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing ElastiCache Serverless Cache tags: %w", err))
+					yield(result)
+					return
+				}
+			}
+
+			for _, cache := range page {
+				name := aws.ToString(cache.ServerlessCacheName)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), name)
+
+				result := request.NewListResult(ctx)
+
+				var data serverlessCacheResourceModel
+				r.SetResult(ctx, awsClient, request.IncludeResource, &data, &result, func() {
+					if diags := fwflex.Flatten(ctx, cache, &data); diags.HasError() {
+						result.Diagnostics.Append(diags...)
+						return
+					}
+					data.setID()
+
+					if request.IncludeResource {
+						setTagsOut(ctx, svcTags(tftags.New(ctx, tags[aws.ToString(cache.ARN)])))
+					}
+
+					result.DisplayName = name
+				})
+
+				if result.Diagnostics.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type listServerlessCacheModel struct {
+	framework.WithRegionModel
+}
+
+func listServerlessCachePages(ctx context.Context, conn *elasticache.Client, input *elasticache.DescribeServerlessCachesInput) iter.Seq2[[]awstypes.ServerlessCache, error] {
+	return func(yield func([]awstypes.ServerlessCache, error) bool) {
+		pages := elasticache.NewDescribeServerlessCachesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing ElastiCache Serverless Caches: %w", err))
+				return
+			}
+
+			if !yield(page.ServerlessCaches, nil) {
+				return
+			}
+		}
+	}
+}
@@ -86,6 +86,10 @@ var (
 
 // @SDKResource("aws_elastic_beanstalk_environment", name="Environment")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types;awstypes;awstypes.EnvironmentDescription")
+// @Testing(importIgnore="setting;wait_for_ready_timeout")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceEnvironment() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -94,10 +98,6 @@ func resourceEnvironment() *schema.Resource {
 		UpdateWithoutTimeout: resourceEnvironmentUpdate,
 		DeleteWithoutTimeout: resourceEnvironmentDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaVersion: 1,
 		MigrateState:  environmentMigrateState,
 
@@ -330,12 +330,22 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta a
 		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceEnvironmentFlatten(ctx, meta.(*conns.AWSClient), env, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceEnvironmentFlatten(ctx context.Context, awsClient *conns.AWSClient, env *awstypes.EnvironmentDescription, d *schema.ResourceData) error {
+	conn := awsClient.ElasticBeanstalkClient(ctx)
+
 	resources, err := conn.DescribeEnvironmentResources(ctx, &elasticbeanstalk.DescribeEnvironmentResourcesInput{
-		EnvironmentId: aws.String(d.Id()),
+		EnvironmentId: env.EnvironmentId,
 	})
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment (%s) resources: %s", d.Id(), err)
+		return fmt.Errorf("reading Elastic Beanstalk Environment (%s) resources: %w", aws.ToString(env.EnvironmentId), err)
 	}
 
 	input := elasticbeanstalk.DescribeConfigurationSettingsInput{
@@ -345,13 +355,13 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta a
 	configurationSettings, err := findConfigurationSettings(ctx, conn, &input)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment (%s) configuration settings: %s", d.Id(), err)
+		return fmt.Errorf("reading Elastic Beanstalk Environment (%s) configuration settings: %w", aws.ToString(env.EnvironmentId), err)
 	}
 
 	d.Set("application", env.ApplicationName)
 	d.Set(names.AttrARN, env.EnvironmentArn)
 	if err := d.Set("autoscaling_groups", flattenAutoScalingGroups(resources.EnvironmentResources.AutoScalingGroups)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting autoscaling_groups: %s", err)
+		return fmt.Errorf("setting autoscaling_groups: %w", err)
 	}
 	cname := aws.ToString(env.CNAME)
 	d.Set("cname", cname)
@@ -369,23 +379,23 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta a
 	d.Set(names.AttrDescription, env.Description)
 	d.Set("endpoint_url", env.EndpointURL)
 	if err := d.Set("instances", flattenInstances(resources.EnvironmentResources.Instances)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting instances: %s", err)
+		return fmt.Errorf("setting instances: %w", err)
 	}
 	if err := d.Set("launch_configurations", flattenLaunchConfigurations(resources.EnvironmentResources.LaunchConfigurations)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting launch_configurations: %s", err)
+		return fmt.Errorf("setting launch_configurations: %w", err)
 	}
 	if err := d.Set("load_balancers", flattenLoadBalancers(resources.EnvironmentResources.LoadBalancers)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting load_balancers: %s", err)
+		return fmt.Errorf("setting load_balancers: %w", err)
 	}
 	d.Set(names.AttrName, env.EnvironmentName)
 	d.Set("platform_arn", env.PlatformArn)
 	if err := d.Set("queues", flattenQueues(resources.EnvironmentResources.Queues)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting queues: %s", err)
+		return fmt.Errorf("setting queues: %w", err)
 	}
 	d.Set("solution_stack_name", env.SolutionStackName)
 	d.Set("tier", env.Tier.Name)
 	if err := d.Set(names.AttrTriggers, flattenTriggers(resources.EnvironmentResources.Triggers)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting triggers: %s", err)
+		return fmt.Errorf("setting triggers: %w", err)
 	}
 	d.Set("version_label", env.VersionLabel)
 
@@ -393,7 +403,7 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta a
 	if v, ok := d.GetOk("setting"); ok && v.(*schema.Set).Len() > 0 {
 		configuredSettings = v.(*schema.Set).List()
 	}
-	apiSettings := flattenConfigurationOptionSettings(ctx, meta, configurationSettings.OptionSettings)
+	apiSettings := flattenConfigurationOptionSettings(ctx, awsClient, configurationSettings.OptionSettings)
 	var settings []any
 
 	for _, apiSetting := range apiSettings {
@@ -411,14 +421,14 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta a
 	}
 
 	if err := d.Set("all_settings", apiSettings); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting all_settings: %s", err)
+		return fmt.Errorf("setting all_settings: %w", err)
 	}
 
 	if err := d.Set("setting", settings); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting setting: %s", err)
+		return fmt.Errorf("setting setting: %w", err)
 	}
 
-	return diags
+	return nil
 }
 
 func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticbeanstalk
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_elastic_beanstalk_environment")
+func newEnvironmentResourceAsListResource() inttypes.ListResourceForSDK {
+	l := environmentListResource{}
+	l.SetResourceSchema(resourceEnvironment())
+
+	return &l
+}
+
+var _ list.ListResource = &environmentListResource{}
+
+type environmentListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type environmentListResourceModel struct {
+	framework.WithRegionModel
+	ApplicationName types.String `tfsdk:"application_name"`
+}
+
+func (l *environmentListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"application_name": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *environmentListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.ElasticBeanstalkClient(ctx)
+
+	var query environmentListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	input := elasticbeanstalk.DescribeEnvironmentsInput{
+		ApplicationName: query.ApplicationName.ValueStringPointer(),
+		IncludeDeleted:  aws.Bool(false),
+	}
+
+	tflog.Info(ctx, "Listing Elastic Beanstalk Environments")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := listTagsForResourceRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		for page, err := range listEnvironmentPages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				arns := tfslices.ApplyToAll(page, func(v awstypes.EnvironmentDescription) string {
+					return aws.ToString(v.EnvironmentArn)
+				})
+
+				tags, err = findEnvironmentTags(ctx, conn, limiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, env := range page {
+				id := aws.ToString(env.EnvironmentId)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(id)
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading Elastic Beanstalk Environment")
+					if err := resourceEnvironmentFlatten(ctx, awsClient, &env, rd); err != nil {
+						tflog.Error(ctx, "Reading Elastic Beanstalk Environment", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tags[aws.ToString(env.EnvironmentArn)]))
+				}
+
+				result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(env.EnvironmentName), env.Health)
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func listEnvironmentPages(ctx context.Context, conn *elasticbeanstalk.Client, input *elasticbeanstalk.DescribeEnvironmentsInput) iter.Seq2[[]awstypes.EnvironmentDescription, error] {
+	return func(yield func([]awstypes.EnvironmentDescription, error) bool) {
+		for {
+			output, err := conn.DescribeEnvironments(ctx, input)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing Elastic Beanstalk Environments: %w", err))
+				return
+			}
+
+			if !yield(output.Environments, nil) {
+				return
+			}
+
+			if aws.ToString(output.NextToken) == "" {
+				return
+			}
+
+			input.NextToken = output.NextToken
+		}
+	}
+}
+
+// findEnvironmentTags returns the tags of each of the specified environments, keyed by ARN.
+// Elastic Beanstalk has no batch tagging API, so ListTagsForResource is called once per environment.
+func findEnvironmentTags(ctx context.Context, conn *elasticbeanstalk.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for Elastic Beanstalk Environment (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
+
+// listTagsForResourceRateLimiters limit the ListTagsForResource calls made to hydrate listed environments, to 10 per second by default.
+var listTagsForResourceRateLimiters = ratelimit.Register("elasticbeanstalk:ListTagsForResource", 10, 10)
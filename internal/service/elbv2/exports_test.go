@@ -14,11 +14,13 @@ var (
 	ResourceTrustStore            = resourceTrustStore
 	ResourceTrustStoreRevocation  = resourceTrustStoreRevocation
 
+	DescribeTagsRateLimiters             = describeTagsRateLimiters
 	FindListenerByARN                    = findListenerByARN
 	FindListenerCertificateByTwoPartKey  = findListenerCertificateByTwoPartKey
 	FindListenerRuleByARN                = findListenerRuleByARN
 	FindLoadBalancerAttributesByARN      = findLoadBalancerAttributesByARN
 	FindLoadBalancerByARN                = findLoadBalancerByARN
+	FindTagsByARNs                       = findTagsByARNs
 	FindTargetHealthDescription          = findTargetHealthDescription
 	FindTrustStoreByARN                  = findTrustStoreByARN
 	FindTrustStoreRevocationByTwoPartKey = findTrustStoreRevocationByTwoPartKey
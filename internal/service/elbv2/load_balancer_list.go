@@ -7,15 +7,18 @@ import (
 	"context"
 	"fmt"
 	"iter"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -46,41 +49,64 @@ func (l *listResourceLoadBalancer) List(ctx context.Context, request list.ListRe
 
 	tflog.Info(ctx, "Listing ELB Load Balancer")
 	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := describeTagsRateLimiters.For(l.Meta().RateLimitScope(ctx))
+
 		var input elasticloadbalancingv2.DescribeLoadBalancersInput
-		for item, err := range listLoadBalancers(ctx, conn, &input) {
+		for page, err := range listLoadBalancerPages(ctx, conn, &input) {
 			if err != nil {
 				result := fwdiag.NewListResultErrorDiagnostic(err)
 				yield(result)
 				return
 			}
 
-			arn := aws.ToString(item.LoadBalancerArn)
-			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrARN), arn)
-
-			result := request.NewListResult(ctx)
-			rd := l.ResourceData()
-			rd.SetId(arn)
-			rd.Set(names.AttrARN, arn)
-
+			// Tags are fetched for the whole page with DescribeTags, which is faster than the Resource Groups Tagging API
+			// and not subject to its eventual consistency.
+			var tags map[string][]awstypes.Tag
 			if request.IncludeResource {
-				if err := resourceLoadBalancerFlatten(ctx, l.Meta(), &item, rd); err != nil {
-					tflog.Error(ctx, "Reading ELB Load Balancer", map[string]any{
-						"error": err.Error(),
-					})
-					continue
+				arns := make([]string, 0, len(page))
+				for _, item := range page {
+					arns = append(arns, aws.ToString(item.LoadBalancerArn))
+				}
+
+				tags, err = findTagsByARNs(ctx, conn, limiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing ELB Load Balancer tags: %w", err))
+					yield(result)
+					return
 				}
 			}
 
-			result.DisplayName = aws.ToString(item.LoadBalancerName)
+			for _, item := range page {
+				arn := aws.ToString(item.LoadBalancerArn)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrARN), arn)
 
-			l.SetResult(ctx, l.Meta(), request.IncludeResource, &result, rd)
-			if result.Diagnostics.HasError() {
-				yield(result)
-				return
-			}
+				result := request.NewListResult(ctx)
+				rd := l.ResourceData()
+				rd.SetId(arn)
+				rd.Set(names.AttrARN, arn)
 
-			if !yield(result) {
-				return
+				if request.IncludeResource {
+					if err := resourceLoadBalancerFlatten(ctx, l.Meta(), &item, rd); err != nil {
+						tflog.Error(ctx, "Reading ELB Load Balancer", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, tags[arn])
+				}
+
+				result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(item.LoadBalancerName), item.Type)
+
+				l.SetResult(ctx, l.Meta(), request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
 			}
 		}
 	}
@@ -90,21 +116,64 @@ type listLoadBalancerModel struct {
 	framework.WithRegionModel
 }
 
-func listLoadBalancers(ctx context.Context, conn *elasticloadbalancingv2.Client, input *elasticloadbalancingv2.DescribeLoadBalancersInput) iter.Seq2[awstypes.LoadBalancer, error] {
-	return func(yield func(awstypes.LoadBalancer, error) bool) {
+func listLoadBalancerPages(ctx context.Context, conn *elasticloadbalancingv2.Client, input *elasticloadbalancingv2.DescribeLoadBalancersInput) iter.Seq2[[]awstypes.LoadBalancer, error] {
+	return func(yield func([]awstypes.LoadBalancer, error) bool) {
 		pages := elasticloadbalancingv2.NewDescribeLoadBalancersPaginator(conn, input)
 		for pages.HasMorePages() {
 			page, err := pages.NextPage(ctx)
 			if err != nil {
-				yield(awstypes.LoadBalancer{}, fmt.Errorf("listing ELB Load Balancer resources: %w", err))
+				yield(nil, fmt.Errorf("listing ELB Load Balancer resources: %w", err))
 				return
 			}
 
-			for _, item := range page.LoadBalancers {
-				if !yield(item, nil) {
-					return
-				}
+			if !yield(page.LoadBalancers, nil) {
+				return
 			}
 		}
 	}
 }
+
+const (
+	// The maximum number of ARNs that can be passed in a single DescribeTags call.
+	describeTagsMaxResourceARNs = 20
+)
+
+// describeTagsRateLimiters limit the DescribeTags calls made to hydrate listed load balancers, to 10 per second by default.
+var describeTagsRateLimiters = ratelimit.Register("elasticloadbalancing:DescribeTags", 10, 10)
+
+type describeTagsAPIClient interface {
+	DescribeTags(context.Context, *elasticloadbalancingv2.DescribeTagsInput, ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTagsOutput, error)
+}
+
+// findTagsByARNs returns the tags of the specified resources, keyed by ARN.
+// ARNs are requested in chunks of at most 20, and throttled chunks are retried.
+// A chunk that includes a load balancer deleted since it was listed fails with LoadBalancerNotFound, and its resources have no tags.
+func findTagsByARNs(ctx context.Context, conn describeTagsAPIClient, limiter *ratelimit.Limiter, arns []string) (map[string][]awstypes.Tag, error) {
+	tags := make(map[string][]awstypes.Tag, len(arns))
+
+	for chunk := range slices.Chunk(arns, describeTagsMaxResourceARNs) {
+		input := elasticloadbalancingv2.DescribeTagsInput{
+			ResourceArns: chunk,
+		}
+		output, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (*elasticloadbalancingv2.DescribeTagsOutput, error) {
+			return conn.DescribeTags(ctx, &input)
+		})
+
+		if errs.IsA[*awstypes.LoadBalancerNotFoundException](err) {
+			tflog.Warn(ctx, "Describing ELB Load Balancer tags", map[string]any{
+				"error": err.Error(),
+			})
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output.TagDescriptions {
+			tags[aws.ToString(v.ResourceArn)] = v.Tags
+		}
+	}
+
+	return tags, nil
+}
@@ -4,10 +4,13 @@
 package elbv2_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -21,6 +24,8 @@ import (
 	tfquerycheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/querycheck"
 	tfqueryfilter "github.com/hashicorp/terraform-provider-aws/internal/acctest/queryfilter"
 	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tfelbv2 "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -72,11 +77,11 @@ func TestAccELBV2LoadBalancer_List_basic(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					tfquerycheck.ExpectIdentityFunc("aws_lb.test", identity1.Checks()),
-					querycheck.ExpectResourceDisplayName("aws_lb.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), knownvalue.StringExact(rName+"-0")),
+					querycheck.ExpectResourceDisplayName("aws_lb.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), knownvalue.StringExact(rName+"-0 (application)")),
 					tfquerycheck.ExpectNoResourceObject("aws_lb.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks())),
 
 					tfquerycheck.ExpectIdentityFunc("aws_lb.test", identity2.Checks()),
-					querycheck.ExpectResourceDisplayName("aws_lb.test", tfqueryfilter.ByResourceIdentityFunc(identity2.Checks()), knownvalue.StringExact(rName+"-1")),
+					querycheck.ExpectResourceDisplayName("aws_lb.test", tfqueryfilter.ByResourceIdentityFunc(identity2.Checks()), knownvalue.StringExact(rName+"-1 (application)")),
 					tfquerycheck.ExpectNoResourceObject("aws_lb.test", tfqueryfilter.ByResourceIdentityFunc(identity2.Checks())),
 				},
 			},
@@ -133,7 +138,7 @@ func TestAccELBV2LoadBalancer_List_includeResource(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					tfquerycheck.ExpectIdentityFunc("aws_lb.test", identity1.Checks()),
-					querycheck.ExpectResourceDisplayName("aws_lb.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), knownvalue.StringExact(rName+"-0")),
+					querycheck.ExpectResourceDisplayName("aws_lb.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), knownvalue.StringExact(rName+"-0 (application)")),
 					querycheck.ExpectResourceKnownValues("aws_lb.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), []querycheck.KnownValueCheck{
 						tfquerycheck.KnownValueCheck(tfjsonpath.New("access_logs"), knownvalue.ListSizeExact(1)),
 						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrARN), tfknownvalue.RegionalARNRegexp("elasticloadbalancing", regexache.MustCompile(fmt.Sprintf("loadbalancer/app/%s/[a-z0-9]{16}", rName+"-0")))),
@@ -161,7 +166,7 @@ func TestAccELBV2LoadBalancer_List_includeResource(t *testing.T) {
 						tfquerycheck.KnownValueCheck(tfjsonpath.New("ipam_pools"), knownvalue.ListSizeExact(0)),
 						tfquerycheck.KnownValueCheck(tfjsonpath.New("load_balancer_type"), tfknownvalue.StringExact(awstypes.LoadBalancerTypeEnumApplication)),
 						tfquerycheck.KnownValueCheck(tfjsonpath.New("minimum_load_balancer_capacity"), knownvalue.ListSizeExact(0)),
-						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName+"-0")),
+						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrName), knownvalue.StringExact(rName+"-0 (application)")),
 						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrNamePrefix), knownvalue.StringExact("")),
 						tfquerycheck.KnownValueCheck(tfjsonpath.New("preserve_host_header"), knownvalue.Bool(false)),
 						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
@@ -243,3 +248,101 @@ func TestAccELBV2LoadBalancer_List_regionOverride(t *testing.T) {
 		},
 	})
 }
+
+func TestFindTagsByARNs(t *testing.T) {
+	t.Parallel()
+
+	var arns []string
+	for i := range 45 {
+		arns = append(arns, fmt.Sprintf("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/test-%d/0123456789abcdef", i)) //lintignore:AWSAT003,AWSAT005
+	}
+
+	conn := &mockDescribeTagsClient{}
+
+	got, err := tfelbv2.FindTagsByARNs(t.Context(), conn, tfelbv2.DescribeTagsRateLimiters.For(ratelimit.Scope{AccountID: "123456789012", Region: "us-west-2"}), arns) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(conn.calls), 3; got != want {
+		t.Fatalf("expected %d DescribeTags calls, got %d", want, got)
+	}
+	for i, want := range []int{20, 20, 5} {
+		if got := len(conn.calls[i]); got != want {
+			t.Errorf("expected %d ARNs in DescribeTags call %d, got %d", want, i, got)
+		}
+	}
+
+	if got, want := len(got), len(arns); got != want {
+		t.Fatalf("expected tags for %d ARNs, got %d", want, got)
+	}
+	for _, arn := range arns {
+		if tags := got[arn]; len(tags) != 1 || aws.ToString(tags[0].Value) != arn {
+			t.Errorf("unexpected tags for %s: %v", arn, tags)
+		}
+	}
+}
+
+func TestFindTagsByARNs_loadBalancerNotFound(t *testing.T) {
+	t.Parallel()
+
+	var arns []string
+	for i := range 45 {
+		arns = append(arns, fmt.Sprintf("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/test-%d/0123456789abcdef", i)) //lintignore:AWSAT003,AWSAT005
+	}
+
+	// A load balancer in the second chunk is deleted after it was listed.
+	conn := &mockDescribeTagsClient{
+		errs: map[int]error{
+			1: &awstypes.LoadBalancerNotFoundException{Message: aws.String("One or more load balancers not found")},
+		},
+	}
+
+	got, err := tfelbv2.FindTagsByARNs(t.Context(), conn, tfelbv2.DescribeTagsRateLimiters.For(ratelimit.Scope{AccountID: "123456789012", Region: "us-west-2"}), arns) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(conn.calls), 3; got != want {
+		t.Fatalf("expected %d DescribeTags calls, got %d", want, got)
+	}
+
+	for i, arn := range arns {
+		tags, ok := got[arn]
+		if i >= 20 && i < 40 {
+			if ok {
+				t.Errorf("unexpected tags for %s: %v", arn, tags)
+			}
+			continue
+		}
+		if len(tags) != 1 || aws.ToString(tags[0].Value) != arn {
+			t.Errorf("unexpected tags for %s: %v", arn, tags)
+		}
+	}
+}
+
+type mockDescribeTagsClient struct {
+	calls [][]string
+	// errs are the errors returned by DescribeTags calls, keyed by call index.
+	errs map[int]error
+}
+
+func (c *mockDescribeTagsClient) DescribeTags(_ context.Context, input *elasticloadbalancingv2.DescribeTagsInput, _ ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeTagsOutput, error) {
+	c.calls = append(c.calls, input.ResourceArns)
+	if err, ok := c.errs[len(c.calls)-1]; ok {
+		return nil, err
+	}
+
+	var output elasticloadbalancingv2.DescribeTagsOutput
+	for _, arn := range input.ResourceArns {
+		output.TagDescriptions = append(output.TagDescriptions, awstypes.TagDescription{
+			ResourceArn: aws.String(arn),
+			Tags: []awstypes.Tag{{
+				Key:   aws.String(names.AttrARN),
+				Value: aws.String(arn),
+			}},
+		})
+	}
+
+	return &output, nil
+}
@@ -7,6 +7,7 @@ package events
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -28,6 +29,11 @@ import (
 
 // @SDKResource("aws_cloudwatch_event_bus", name="Event Bus")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(name="Bus")
+// @Testing(idAttrDuplicates="name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/eventbridge;eventbridge.DescribeEventBusOutput")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceBus() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBusCreate,
@@ -35,10 +41,6 @@ func resourceBus() *schema.Resource {
 		UpdateWithoutTimeout: resourceBusUpdate,
 		DeleteWithoutTimeout: resourceBusDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -186,18 +188,26 @@ func resourceBusRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		return sdkdiag.AppendErrorf(diags, "reading EventBridge Event Bus (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceBusFlatten(ctx, output, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceBusFlatten(_ context.Context, output *eventbridge.DescribeEventBusOutput, d *schema.ResourceData) error {
 	d.Set(names.AttrARN, output.Arn)
 	if err := d.Set("dead_letter_config", flattenDeadLetterConfig(output.DeadLetterConfig)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting dead_letter_config: %s", err)
+		return fmt.Errorf("setting dead_letter_config: %w", err)
 	}
 	d.Set(names.AttrDescription, output.Description)
 	d.Set("kms_key_identifier", output.KmsKeyIdentifier)
 	if err := d.Set("log_config", flattenLogConfig(output.LogConfig)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting log_config: %s", err)
+		return fmt.Errorf("setting log_config: %w", err)
 	}
 	d.Set(names.AttrName, output.Name)
 
-	return diags
+	return nil
 }
 
 func resourceBusUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
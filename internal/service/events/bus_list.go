@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_cloudwatch_event_bus")
+func newBusResourceAsListResource() inttypes.ListResourceForSDK {
+	l := busListResource{}
+	l.SetResourceSchema(resourceBus())
+
+	return &l
+}
+
+var _ list.ListResource = &busListResource{}
+
+type busListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type busListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *busListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EventsClient(ctx)
+
+	var query busListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing EventBridge Event Buses")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := listTagsForResourceRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input eventbridge.ListEventBusesInput
+		for page, err := range listEventBusPages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				arns := make([]string, 0, len(page))
+				for _, bus := range page {
+					arns = append(arns, aws.ToString(bus.Arn))
+				}
+
+				tags, err = findEventBusTags(ctx, conn, limiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, bus := range page {
+				name := aws.ToString(bus.Name)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), name)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(name)
+				rd.Set(names.AttrName, name)
+
+				if request.IncludeResource {
+					// ListEventBuses doesn't return the dead-letter, encryption or logging configuration.
+					tflog.Info(ctx, "Reading EventBridge Event Bus")
+					output, err := findEventBusByName(ctx, conn, name)
+					if err != nil {
+						tflog.Error(ctx, "Reading EventBridge Event Bus", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					if err := resourceBusFlatten(ctx, output, rd); err != nil {
+						tflog.Error(ctx, "Reading EventBridge Event Bus", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tags[aws.ToString(bus.Arn)]))
+				}
+
+				result.DisplayName = busDisplayName(name)
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// busDisplayName returns the display name of a listed event bus.
+// The account's default event bus, which exists in every account and is managed by AWS, is flagged as such.
+func busDisplayName(name string) string {
+	if name == DefaultEventBusName {
+		return fmt.Sprintf("%s (managed by AWS)", name)
+	}
+
+	return name
+}
+
+func listEventBusPages(ctx context.Context, conn *eventbridge.Client, input *eventbridge.ListEventBusesInput) iter.Seq2[[]awstypes.EventBus, error] {
+	return func(yield func([]awstypes.EventBus, error) bool) {
+		err := listEventBusesPages(ctx, conn, input, func(page *eventbridge.ListEventBusesOutput, lastPage bool) bool {
+			return yield(page.EventBuses, nil) && !lastPage
+		})
+		if err != nil {
+			yield(nil, fmt.Errorf("listing EventBridge Event Buses: %w", err))
+			return
+		}
+	}
+}
+
+// findEventBusTags returns the tags of each of the specified event buses, keyed by ARN.
+func findEventBusTags(ctx context.Context, conn *eventbridge.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for EventBridge Event Bus (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
+
+// listTagsForResourceRateLimiters limit the ListTagsForResource calls made to hydrate listed event buses, to 10 per second by default.
+var listTagsForResourceRateLimiters = ratelimit.Register("events:ListTagsForResource", 10, 10)
@@ -15,6 +15,7 @@ var (
 	ResourceRule           = resourceRule
 	ResourceTarget         = resourceTarget
 
+	BusDisplayName              = busDisplayName
 	FindAPIDestinationByName    = findAPIDestinationByName
 	FindArchiveByName           = findArchiveByName
 	FindConnectionByName        = findConnectionByName
@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -64,6 +65,10 @@ func (destinationType) Values() []destinationType {
 
 // @SDKResource("aws_kinesis_firehose_delivery_stream", name="Delivery Stream")
 // @Tags(identifierAttribute="name")
+// @ArnIdentity
+// @CustomImport
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/firehose/types;types.DeliveryStreamDescription")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceDeliveryStream() *schema.Resource {
 	// lintignore:R011
 	return &schema.Resource{
@@ -74,6 +79,10 @@ func resourceDeliveryStream() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				if err := importer.Import(ctx, d, meta); err != nil {
+					return nil, err
+				}
+
 				idErr := fmt.Errorf("Expected ID in format of arn:PARTITION:firehose:REGION:ACCOUNTID:deliverystream/NAME and provided: %s", d.Id())
 				resARN, err := arn.Parse(d.Id())
 				if err != nil {
@@ -1595,16 +1604,24 @@ func resourceDeliveryStreamRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading Kinesis Firehose Delivery Stream (%s): %s", sn, err)
 	}
 
+	if err := resourceDeliveryStreamFlatten(d, s); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceDeliveryStreamFlatten(d *schema.ResourceData, s *types.DeliveryStreamDescription) error {
 	d.Set(names.AttrARN, s.DeliveryStreamARN)
 	if v := s.Source; v != nil {
 		if v := v.KinesisStreamSourceDescription; v != nil {
 			if err := d.Set("kinesis_source_configuration", flattenKinesisStreamSourceDescription(v)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting kinesis_source_configuration: %s", err)
+				return fmt.Errorf("setting kinesis_source_configuration: %w", err)
 			}
 		}
 		if v := v.MSKSourceDescription; v != nil {
 			if err := d.Set("msk_source_configuration", []any{flattenMSKSourceDescription(v)}); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting msk_source_configuration: %s", err)
+				return fmt.Errorf("setting msk_source_configuration: %w", err)
 			}
 		}
 	}
@@ -1624,7 +1641,7 @@ func resourceDeliveryStreamRead(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 	if err := d.Set("server_side_encryption", []map[string]any{sseOptions}); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting server_side_encryption: %s", err)
+		return fmt.Errorf("setting server_side_encryption: %w", err)
 	}
 
 	if len(s.Destinations) > 0 {
@@ -1633,57 +1650,57 @@ func resourceDeliveryStreamRead(ctx context.Context, d *schema.ResourceData, met
 		case destination.ElasticsearchDestinationDescription != nil:
 			d.Set(names.AttrDestination, destinationTypeElasticsearch)
 			if err := d.Set("elasticsearch_configuration", flattenElasticsearchDestinationDescription(destination.ElasticsearchDestinationDescription)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting elasticsearch_configuration: %s", err)
+				return fmt.Errorf("setting elasticsearch_configuration: %w", err)
 			}
 		case destination.HttpEndpointDestinationDescription != nil:
 			d.Set(names.AttrDestination, destinationTypeHTTPEndpoint)
 			configuredAccessKey := d.Get("http_endpoint_configuration.0.access_key").(string)
 			if err := d.Set("http_endpoint_configuration", flattenHTTPEndpointDestinationDescription(destination.HttpEndpointDestinationDescription, configuredAccessKey)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting http_endpoint_configuration: %s", err)
+				return fmt.Errorf("setting http_endpoint_configuration: %w", err)
 			}
 		case destination.IcebergDestinationDescription != nil:
 			d.Set(names.AttrDestination, destinationTypeIceberg)
 			if err := d.Set("iceberg_configuration", flattenIcebergDestinationDescription(destination.IcebergDestinationDescription)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting iceberg_configuration: %s", err)
+				return fmt.Errorf("setting iceberg_configuration: %w", err)
 			}
 		case destination.AmazonopensearchserviceDestinationDescription != nil:
 			d.Set(names.AttrDestination, destinationTypeOpenSearch)
 			if err := d.Set("opensearch_configuration", flattenAmazonopensearchserviceDestinationDescription(destination.AmazonopensearchserviceDestinationDescription)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting opensearch_configuration: %s", err)
+				return fmt.Errorf("setting opensearch_configuration: %w", err)
 			}
 		case destination.AmazonOpenSearchServerlessDestinationDescription != nil:
 			d.Set(names.AttrDestination, destinationTypeOpenSearchServerless)
 			if err := d.Set("opensearchserverless_configuration", flattenAmazonOpenSearchServerlessDestinationDescription(destination.AmazonOpenSearchServerlessDestinationDescription)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting opensearchserverless_configuration: %s", err)
+				return fmt.Errorf("setting opensearchserverless_configuration: %w", err)
 			}
 		case destination.RedshiftDestinationDescription != nil:
 			d.Set(names.AttrDestination, destinationTypeRedshift)
 			configuredPassword := d.Get("redshift_configuration.0.password").(string)
 			if err := d.Set("redshift_configuration", flattenRedshiftDestinationDescription(destination.RedshiftDestinationDescription, configuredPassword)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting redshift_configuration: %s", err)
+				return fmt.Errorf("setting redshift_configuration: %w", err)
 			}
 		case destination.SnowflakeDestinationDescription != nil:
 			d.Set(names.AttrDestination, destinationTypeSnowflake)
 			configuredKeyPassphrase := d.Get("snowflake_configuration.0.key_passphrase").(string)
 			configuredPrivateKey := d.Get("snowflake_configuration.0.private_key").(string)
 			if err := d.Set("snowflake_configuration", flattenSnowflakeDestinationDescription(destination.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting snowflake_configuration: %s", err)
+				return fmt.Errorf("setting snowflake_configuration: %w", err)
 			}
 		case destination.SplunkDestinationDescription != nil:
 			d.Set(names.AttrDestination, destinationTypeSplunk)
 			if err := d.Set("splunk_configuration", flattenSplunkDestinationDescription(destination.SplunkDestinationDescription)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting splunk_configuration: %s", err)
+				return fmt.Errorf("setting splunk_configuration: %w", err)
 			}
 		default:
 			d.Set(names.AttrDestination, destinationTypeExtendedS3)
 			if err := d.Set("extended_s3_configuration", flattenExtendedS3DestinationDescription(destination.ExtendedS3DestinationDescription)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting extended_s3_configuration: %s", err)
+				return fmt.Errorf("setting extended_s3_configuration: %w", err)
 			}
 		}
 		d.Set("destination_id", destination.DestinationId)
 	}
 
-	return diags
+	return nil
 }
 
 func resourceDeliveryStreamUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package firehose
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_kinesis_firehose_delivery_stream")
+func newDeliveryStreamResourceAsListResource() inttypes.ListResourceForSDK {
+	l := deliveryStreamListResource{}
+	l.SetResourceSchema(resourceDeliveryStream())
+
+	return &l
+}
+
+var _ list.ListResource = &deliveryStreamListResource{}
+
+type deliveryStreamListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type deliveryStreamListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *deliveryStreamListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.FirehoseClient(ctx)
+
+	var query deliveryStreamListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing Kinesis Firehose Delivery Streams")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		describeLimiter := describeDeliveryStreamRateLimiters.For(awsClient.RateLimitScope(ctx))
+		listTagsLimiter := listTagsForDeliveryStreamRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input firehose.ListDeliveryStreamsInput
+		for page, err := range listDeliveryStreamNamePages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// ListDeliveryStreams returns only names, so each delivery stream is described
+			// for its ARN and destination before any results are returned.
+			deliveryStreams, err := findDeliveryStreamsByNames(ctx, conn, describeLimiter, page)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				tags, err = findDeliveryStreamTags(ctx, conn, listTagsLimiter, page)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, name := range page {
+				deliveryStream, ok := deliveryStreams[name]
+				if !ok {
+					// Delivery stream was deleted after it was listed.
+					continue
+				}
+
+				arn := aws.ToString(deliveryStream.DeliveryStreamARN)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(arn)
+				rd.Set(names.AttrARN, arn)
+				rd.Set(names.AttrName, name)
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading Kinesis Firehose Delivery Stream")
+					if err := resourceDeliveryStreamFlatten(rd, deliveryStream); err != nil {
+						tflog.Error(ctx, "Reading Kinesis Firehose Delivery Stream", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tags[name]))
+				}
+
+				result.DisplayName = fmt.Sprintf("%s (%s)", name, deliveryStreamDestinationType(deliveryStream))
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// deliveryStreamDestinationType returns the value of the destination argument for the specified delivery stream.
+func deliveryStreamDestinationType(v *types.DeliveryStreamDescription) destinationType {
+	if len(v.Destinations) == 0 {
+		return ""
+	}
+
+	switch destination := v.Destinations[0]; {
+	case destination.ElasticsearchDestinationDescription != nil:
+		return destinationTypeElasticsearch
+	case destination.HttpEndpointDestinationDescription != nil:
+		return destinationTypeHTTPEndpoint
+	case destination.IcebergDestinationDescription != nil:
+		return destinationTypeIceberg
+	case destination.AmazonopensearchserviceDestinationDescription != nil:
+		return destinationTypeOpenSearch
+	case destination.AmazonOpenSearchServerlessDestinationDescription != nil:
+		return destinationTypeOpenSearchServerless
+	case destination.RedshiftDestinationDescription != nil:
+		return destinationTypeRedshift
+	case destination.SnowflakeDestinationDescription != nil:
+		return destinationTypeSnowflake
+	case destination.SplunkDestinationDescription != nil:
+		return destinationTypeSplunk
+	default:
+		return destinationTypeExtendedS3
+	}
+}
+
+func listDeliveryStreamNamePages(ctx context.Context, conn *firehose.Client, input *firehose.ListDeliveryStreamsInput) iter.Seq2[[]string, error] {
+	return func(yield func([]string, error) bool) {
+		err := listDeliveryStreamsPages(ctx, conn, input, func(page *firehose.ListDeliveryStreamsOutput, _ bool) bool {
+			return yield(page.DeliveryStreamNames, nil)
+		})
+		if err != nil {
+			yield(nil, fmt.Errorf("listing Kinesis Firehose Delivery Streams: %w", err))
+		}
+	}
+}
+
+// findDeliveryStreamsByNames returns the description of each of the specified delivery streams, keyed by name.
+// Delivery streams that no longer exist are omitted.
+func findDeliveryStreamsByNames(ctx context.Context, conn *firehose.Client, limiter *ratelimit.Limiter, deliveryStreamNames []string) (map[string]*types.DeliveryStreamDescription, error) {
+	deliveryStreams := make(map[string]*types.DeliveryStreamDescription, len(deliveryStreamNames))
+
+	for _, name := range deliveryStreamNames {
+		output, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (*types.DeliveryStreamDescription, error) {
+			return findDeliveryStreamByName(ctx, conn, name)
+		})
+		if retry.NotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading Kinesis Firehose Delivery Stream (%s): %w", name, err)
+		}
+
+		deliveryStreams[name] = output
+	}
+
+	return deliveryStreams, nil
+}
+
+// findDeliveryStreamTags returns the tags of each of the specified delivery streams, keyed by name.
+// Kinesis Firehose has no batch tagging API, so ListTagsForDeliveryStream is called once per delivery stream.
+func findDeliveryStreamTags(ctx context.Context, conn *firehose.Client, limiter *ratelimit.Limiter, deliveryStreamNames []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(deliveryStreamNames))
+
+	for _, name := range deliveryStreamNames {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, name)
+		})
+		if retry.NotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for Kinesis Firehose Delivery Stream (%s): %w", name, err)
+		}
+
+		tags[name] = v
+	}
+
+	return tags, nil
+}
+
+// describeDeliveryStreamRateLimiters and listTagsForDeliveryStreamRateLimiters limit the calls made to hydrate listed delivery streams,
+// each to 10 per second by default.
+var (
+	describeDeliveryStreamRateLimiters    = ratelimit.Register("firehose:DescribeDeliveryStream", 10, 10)
+	listTagsForDeliveryStreamRateLimiters = ratelimit.Register("firehose:ListTagsForDeliveryStream", 10, 10)
+)
@@ -7,6 +7,7 @@ package globalaccelerator
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -204,28 +205,35 @@ func resourceAcceleratorRead(ctx context.Context, d *schema.ResourceData, meta a
 		return sdkdiag.AppendErrorf(diags, "reading Global Accelerator Accelerator (%s): %s", d.Id(), err)
 	}
 
+	acceleratorAttributes, err := findAcceleratorAttributesByARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Global Accelerator Accelerator (%s) attributes: %s", d.Id(), err)
+	}
+
+	if err := resourceAcceleratorFlatten(ctx, meta.(*conns.AWSClient), accelerator, acceleratorAttributes, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceAcceleratorFlatten(ctx context.Context, awsClient *conns.AWSClient, accelerator *awstypes.Accelerator, acceleratorAttributes *awstypes.AcceleratorAttributes, d *schema.ResourceData) error {
 	d.Set(names.AttrARN, accelerator.AcceleratorArn)
 	d.Set(names.AttrDNSName, accelerator.DnsName)
 	d.Set("dual_stack_dns_name", accelerator.DualStackDnsName)
 	d.Set(names.AttrEnabled, accelerator.Enabled)
-	d.Set(names.AttrHostedZoneID, meta.(*conns.AWSClient).GlobalAcceleratorHostedZoneID(ctx))
+	d.Set(names.AttrHostedZoneID, awsClient.GlobalAcceleratorHostedZoneID(ctx))
 	d.Set(names.AttrIPAddressType, accelerator.IpAddressType)
 	if err := d.Set("ip_sets", flattenIPSets(accelerator.IpSets)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ip_sets: %s", err)
+		return fmt.Errorf("setting ip_sets: %w", err)
 	}
 	d.Set(names.AttrName, accelerator.Name)
-
-	acceleratorAttributes, err := findAcceleratorAttributesByARN(ctx, conn, d.Id())
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Global Accelerator Accelerator (%s) attributes: %s", d.Id(), err)
-	}
-
 	if err := d.Set(names.AttrAttributes, []any{flattenAcceleratorAttributes(acceleratorAttributes)}); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting attributes: %s", err)
+		return fmt.Errorf("setting attributes: %w", err)
 	}
 
-	return diags
+	return nil
 }
 
 func resourceAcceleratorUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_globalaccelerator_accelerator")
+func newAcceleratorResourceAsListResource() inttypes.ListResourceForSDK {
+	l := acceleratorListResource{}
+	l.SetResourceSchema(resourceAccelerator())
+
+	return &l
+}
+
+var _ list.ListResource = &acceleratorListResource{}
+
+type acceleratorListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+// Global Accelerator is a global service whose API is only served from us-west-2,
+// so the query has no region argument.
+type acceleratorListResourceModel struct{}
+
+func (l *acceleratorListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.GlobalAcceleratorClient(ctx)
+
+	var query acceleratorListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing Global Accelerator Accelerators")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		describeAttributesLimiter := describeAcceleratorAttributesRateLimiters.For(awsClient.RateLimitScope(ctx))
+		listTagsLimiter := listTagsForResourceRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input globalaccelerator.ListAcceleratorsInput
+		for page, err := range listAcceleratorPages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				arns := make([]string, 0, len(page))
+				for _, accelerator := range page {
+					arns = append(arns, aws.ToString(accelerator.AcceleratorArn))
+				}
+
+				tags, err = findAcceleratorTags(ctx, conn, listTagsLimiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, accelerator := range page {
+				arn := aws.ToString(accelerator.AcceleratorArn)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(arn)
+				rd.Set(names.AttrARN, arn)
+
+				if request.IncludeResource {
+					// Attributes aren't read once listing has been canceled.
+					if err := ctx.Err(); err != nil {
+						result := fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+
+					tflog.Info(ctx, "Reading Global Accelerator Accelerator")
+					attributes, err := ratelimit.Call(ctx, describeAttributesLimiter, func(ctx context.Context) (*awstypes.AcceleratorAttributes, error) {
+						return findAcceleratorAttributesByARN(ctx, conn, arn)
+					})
+					if err != nil {
+						tflog.Error(ctx, "Reading Global Accelerator Accelerator attributes", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					if err := resourceAcceleratorFlatten(ctx, awsClient, &accelerator, attributes, rd); err != nil {
+						tflog.Error(ctx, "Reading Global Accelerator Accelerator", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tags[arn]))
+				}
+
+				result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(accelerator.Name), aws.ToString(accelerator.DnsName))
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func listAcceleratorPages(ctx context.Context, conn *globalaccelerator.Client, input *globalaccelerator.ListAcceleratorsInput) iter.Seq2[[]awstypes.Accelerator, error] {
+	return func(yield func([]awstypes.Accelerator, error) bool) {
+		pages := globalaccelerator.NewListAcceleratorsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing Global Accelerator Accelerators: %w", err))
+				return
+			}
+
+			if !yield(page.Accelerators, nil) {
+				return
+			}
+		}
+	}
+}
+
+// findAcceleratorTags returns the tags of each of the specified accelerators, keyed by ARN.
+// The Resource Groups Tagging API is not available for Global Accelerator, so ListTagsForResource is called once per accelerator.
+func findAcceleratorTags(ctx context.Context, conn *globalaccelerator.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for Global Accelerator Accelerator (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
+
+// describeAcceleratorAttributesRateLimiters and listTagsForResourceRateLimiters limit the calls made to hydrate listed accelerators,
+// each to 10 per second by default.
+var (
+	describeAcceleratorAttributesRateLimiters = ratelimit.Register("globalaccelerator:DescribeAcceleratorAttributes", 10, 10)
+	listTagsForResourceRateLimiters           = ratelimit.Register("globalaccelerator:ListTagsForResource", 10, 10)
+)
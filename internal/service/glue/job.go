@@ -32,6 +32,10 @@ import (
 
 // @SDKResource("aws_glue_job", name="Job")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/glue/types;awstypes;awstypes.Job")
+// @Testing(idAttrDuplicates="name")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceJob() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceJobCreate,
@@ -39,10 +43,6 @@ func resourceJob() *schema.Resource {
 		UpdateWithoutTimeout: resourceJobUpdate,
 		DeleteWithoutTimeout: resourceJobDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		CustomizeDiff: resourceJobCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
@@ -362,18 +362,26 @@ func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		return sdkdiag.AppendErrorf(diags, "reading Glue Job (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, jobARN(ctx, meta.(*conns.AWSClient), d.Id()))
+	if err := resourceJobFlatten(ctx, meta.(*conns.AWSClient), job, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceJobFlatten(ctx context.Context, awsClient *conns.AWSClient, job *awstypes.Job, d *schema.ResourceData) error {
+	d.Set(names.AttrARN, jobARN(ctx, awsClient, aws.ToString(job.Name)))
 	if err := d.Set("command", flattenJobCommand(job.Command)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting command: %s", err)
+		return fmt.Errorf("setting command: %w", err)
 	}
 	if err := d.Set("connections", flattenConnectionsList(job.Connections)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting connections: %s", err)
+		return fmt.Errorf("setting connections: %w", err)
 	}
 	d.Set("default_arguments", job.DefaultArguments)
 	d.Set(names.AttrDescription, job.Description)
 	d.Set("execution_class", job.ExecutionClass)
 	if err := d.Set("execution_property", flattenExecutionProperty(job.ExecutionProperty)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting execution_property: %s", err)
+		return fmt.Errorf("setting execution_property: %w", err)
 	}
 	d.Set("glue_version", job.GlueVersion)
 	d.Set("job_mode", job.JobMode)
@@ -384,18 +392,18 @@ func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 	d.Set(names.AttrName, job.Name)
 	d.Set("non_overridable_arguments", job.NonOverridableArguments)
 	if err := d.Set("notification_property", flattenNotificationProperty(job.NotificationProperty)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting notification_property: %s", err)
+		return fmt.Errorf("setting notification_property: %w", err)
 	}
 	d.Set("number_of_workers", job.NumberOfWorkers)
 	d.Set(names.AttrRoleARN, job.Role)
 	d.Set("security_configuration", job.SecurityConfiguration)
 	if err := d.Set("source_control_details", flattenSourceControlDetails(job.SourceControlDetails)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting source_control_details: %s", err)
+		return fmt.Errorf("setting source_control_details: %w", err)
 	}
 	d.Set(names.AttrTimeout, job.Timeout)
 	d.Set("worker_type", job.WorkerType)
 
-	return diags
+	return nil
 }
 
 func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_glue_job")
+func newJobResourceAsListResource() inttypes.ListResourceForSDK {
+	l := jobListResource{}
+	l.SetResourceSchema(resourceJob())
+
+	return &l
+}
+
+var _ list.ListResource = &jobListResource{}
+
+type jobListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type jobListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *jobListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.GlueClient(ctx)
+
+	var query jobListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing Glue Jobs")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := getTagsRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input glue.GetJobsInput
+		if request.Limit > 0 && request.Limit < getJobsMaxResults {
+			input.MaxResults = aws.Int32(int32(request.Limit))
+		}
+
+		var count int64
+		for page, err := range listJobPages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if request.Limit > 0 {
+				if remaining := request.Limit - count; int64(len(page)) > remaining {
+					page = page[:remaining]
+				}
+			}
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				arns := make([]string, 0, len(page))
+				for _, job := range page {
+					arns = append(arns, jobARN(ctx, awsClient, aws.ToString(job.Name)))
+				}
+
+				tags, err = findJobTags(ctx, conn, limiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, job := range page {
+				name := aws.ToString(job.Name)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), name)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(name)
+				rd.Set(names.AttrName, name)
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading Glue Job")
+					if err := resourceJobFlatten(ctx, awsClient, &job, rd); err != nil {
+						tflog.Error(ctx, "Reading Glue Job", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tags[jobARN(ctx, awsClient, name)]))
+				}
+
+				result.DisplayName = jobDisplayName(&job)
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				count++
+				if !yield(result) {
+					return
+				}
+			}
+
+			if request.Limit > 0 && count >= request.Limit {
+				return
+			}
+		}
+	}
+}
+
+// jobDisplayName returns a job's name and Glue version, e.g. "example (Glue 5.0)".
+func jobDisplayName(job *awstypes.Job) string {
+	name := aws.ToString(job.Name)
+
+	if v := aws.ToString(job.GlueVersion); v != "" {
+		return fmt.Sprintf("%s (Glue %s)", name, v)
+	}
+
+	return name
+}
+
+const (
+	// The maximum number of jobs that GetJobs returns in a single page.
+	getJobsMaxResults = 1000
+)
+
+func listJobPages(ctx context.Context, conn *glue.Client, input *glue.GetJobsInput) iter.Seq2[[]awstypes.Job, error] {
+	return func(yield func([]awstypes.Job, error) bool) {
+		pages := glue.NewGetJobsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing Glue Jobs: %w", err))
+				return
+			}
+
+			if !yield(page.Jobs, nil) {
+				return
+			}
+		}
+	}
+}
+
+// findJobTags returns the tags of each of the specified jobs, keyed by ARN.
+// Glue has no batch tagging API, so GetTags is called once per job.
+func findJobTags(ctx context.Context, conn *glue.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for Glue Job (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
+
+// getTagsRateLimiters limit the GetTags calls made to hydrate listed jobs, to 10 per second by default.
+var getTagsRateLimiters = ratelimit.Register("glue:GetTags", 10, 10)
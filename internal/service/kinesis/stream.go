@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -34,6 +35,12 @@ import (
 
 // @SDKResource("aws_kinesis_stream", name="Stream")
 // @Tags(identifierAttribute="name", resourceType="Stream")
+// @IdentityAttribute("name")
+// @CustomImport
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kinesis/types;types.StreamDescriptionSummary")
+// @Testing(importStateIdAttribute="name")
+// @Testing(importIgnore="enforce_consumer_deletion")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceStream() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStreamCreate,
@@ -294,6 +301,14 @@ func resourceStreamRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		return sdkdiag.AppendErrorf(diags, "reading Kinesis Stream (%s): %s", name, err)
 	}
 
+	if err := resourceStreamFlatten(d, stream); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceStreamFlatten(d *schema.ResourceData, stream *types.StreamDescriptionSummary) error {
 	d.Set(names.AttrARN, stream.StreamARN)
 	d.Set("encryption_type", stream.EncryptionType)
 	d.Set(names.AttrKMSKeyID, stream.KeyId)
@@ -316,13 +331,13 @@ func resourceStreamRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	d.Set("shard_level_metrics", shardLevelMetrics)
 	if details := stream.StreamModeDetails; details != nil {
 		if err := d.Set("stream_mode_details", []any{flattenStreamModeDetails(details)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting stream_mode_details: %s", err)
+			return fmt.Errorf("setting stream_mode_details: %w", err)
 		}
 	} else {
 		d.Set("stream_mode_details", nil)
 	}
 
-	return diags
+	return nil
 }
 
 func resourceStreamUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -543,9 +558,13 @@ func resourceStreamDelete(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceStreamImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := importer.Import(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
 	conn := meta.(*conns.AWSClient).KinesisClient(ctx)
 
-	output, err := findStreamByName(ctx, conn, d.Id())
+	output, err := findStreamByName(ctx, conn, d.Get(names.AttrName).(string))
 
 	if err != nil {
 		return nil, err
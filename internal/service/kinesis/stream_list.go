@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesis
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_kinesis_stream")
+func newStreamResourceAsListResource() inttypes.ListResourceForSDK {
+	l := streamListResource{}
+	l.SetResourceSchema(resourceStream())
+
+	return &l
+}
+
+var _ list.ListResource = &streamListResource{}
+
+type streamListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type streamListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *streamListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.KinesisClient(ctx)
+
+	var query streamListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing Kinesis Streams")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := describeStreamSummaryRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input kinesis.ListStreamsInput
+		for page, err := range listStreamPages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// ListStreams returns only stream names, so each stream is described to obtain its shard count and encryption settings.
+			streams := make([]*types.StreamDescriptionSummary, 0, len(page))
+			for _, name := range page {
+				v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (*types.StreamDescriptionSummary, error) {
+					return findStreamByName(ctx, conn, name)
+				})
+				if retry.NotFound(err) {
+					continue
+				}
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading Kinesis Stream (%s): %w", name, err))
+					yield(result)
+					return
+				}
+
+				streams = append(streams, v)
+			}
+
+			// Tags are fetched for the whole page in as few Resource Groups Tagging API calls as possible
+			// rather than with one ListTagsForStream call per stream.
+			var tags map[string]map[string]string
+			if request.IncludeResource {
+				arns := make([]string, 0, len(streams))
+				for _, v := range streams {
+					arns = append(arns, aws.ToString(v.StreamARN))
+				}
+
+				tags, err = tftags.BatchFetchResourceTags(ctx, awsClient.ResourceGroupsTaggingAPIClient(ctx), awsClient.RateLimitScope(ctx), arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing Kinesis Stream tags: %w", err))
+					yield(result)
+					return
+				}
+			}
+
+			for _, v := range streams {
+				name, arn := aws.ToString(v.StreamName), aws.ToString(v.StreamARN)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), name)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(arn)
+				rd.Set(names.AttrName, name)
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading Kinesis Stream")
+					if err := resourceStreamFlatten(rd, v); err != nil {
+						tflog.Error(ctx, "Reading Kinesis Stream", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tftags.New(ctx, tags[arn])))
+				}
+
+				result.DisplayName = fmt.Sprintf("%s (%d shards)", name, aws.ToInt32(v.OpenShardCount))
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func listStreamPages(ctx context.Context, conn *kinesis.Client, input *kinesis.ListStreamsInput) iter.Seq2[[]string, error] {
+	return func(yield func([]string, error) bool) {
+		pages := kinesis.NewListStreamsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing Kinesis Streams: %w", err))
+				return
+			}
+
+			if !yield(page.StreamNames, nil) {
+				return
+			}
+		}
+	}
+}
+
+// describeStreamSummaryRateLimiters limit the DescribeStreamSummary calls made to hydrate listed streams.
+// DescribeStreamSummary is limited to 20 transactions per second per account, so streams are hydrated at no more than 10 per second by default.
+var describeStreamSummaryRateLimiters = ratelimit.Register("kinesis:DescribeStreamSummary", 10, 10)
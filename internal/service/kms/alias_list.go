@@ -7,16 +7,20 @@ import (
 	"context"
 	"fmt"
 	"iter"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -34,6 +38,17 @@ type aliasListResource struct {
 
 type aliasListResourceModel struct {
 	framework.WithRegionModel
+	CustomerManagedOnly types.Bool `tfsdk:"customer_managed_only"`
+}
+
+func (l *aliasListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"customer_managed_only": listschema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
 }
 
 func (l *aliasListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
@@ -48,9 +63,16 @@ func (l *aliasListResource) List(ctx context.Context, request list.ListRequest,
 	awsClient := l.Meta()
 	conn := awsClient.KMSClient(ctx)
 
+	filter := aliasCustomerManagedOnlyFilter(query.CustomerManagedOnly)
+
 	tflog.Info(ctx, "Listing KMS aliases")
 	stream.Results = func(yield func(list.ListResult) bool) {
 		var input kms.ListAliasesInput
+		if request.Limit > 0 && request.Limit < listAliasesMaxLimit {
+			input.Limit = aws.Int32(int32(request.Limit))
+		}
+
+		var count int64
 		for item, err := range listAliases(ctx, conn, &input) {
 			if err != nil {
 				result := fwdiag.NewListResultErrorDiagnostic(err)
@@ -58,6 +80,14 @@ func (l *aliasListResource) List(ctx context.Context, request list.ListRequest,
 				return
 			}
 
+			if !filter(&item) {
+				continue
+			}
+
+			if request.Limit > 0 && count >= request.Limit {
+				return
+			}
+
 			id := aws.ToString(item.AliasName)
 			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
 
@@ -78,6 +108,9 @@ func (l *aliasListResource) List(ctx context.Context, request list.ListRequest,
 			}
 
 			result.DisplayName = id
+			if v := aws.ToString(item.TargetKeyId); v != "" {
+				result.DisplayName = fmt.Sprintf("%s (%s)", id, v)
+			}
 
 			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
 			if result.Diagnostics.HasError() {
@@ -85,6 +118,7 @@ func (l *aliasListResource) List(ctx context.Context, request list.ListRequest,
 				return
 			}
 
+			count++
 			if !yield(result) {
 				return
 			}
@@ -92,6 +126,23 @@ func (l *aliasListResource) List(ctx context.Context, request list.ListRequest,
 	}
 }
 
+const (
+	// The maximum number of aliases that ListAliases returns in a single page.
+	listAliasesMaxLimit = 100
+)
+
+// aliasCustomerManagedOnlyFilter returns a predicate excluding the AWS managed "alias/aws/*" aliases.
+// A null or false value selects all aliases.
+func aliasCustomerManagedOnlyFilter(customerManagedOnly types.Bool) tfslices.Predicate[*awstypes.AliasListEntry] {
+	if !customerManagedOnly.ValueBool() {
+		return tfslices.PredicateTrue[*awstypes.AliasListEntry]()
+	}
+
+	return func(v *awstypes.AliasListEntry) bool {
+		return !strings.HasPrefix(aws.ToString(v.AliasName), cmkAliasPrefix)
+	}
+}
+
 func listAliases(ctx context.Context, conn *kms.Client, input *kms.ListAliasesInput) iter.Seq2[awstypes.AliasListEntry, error] {
 	return func(yield func(awstypes.AliasListEntry, error) bool) {
 		pages := kms.NewListAliasesPaginator(conn, input)
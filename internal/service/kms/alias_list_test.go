@@ -6,6 +6,7 @@ package kms_test
 import (
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -104,7 +105,7 @@ func TestAccKMSAlias_List_includeResource(t *testing.T) {
 				},
 				QueryResultChecks: []querycheck.QueryResultCheck{
 					tfquerycheck.ExpectIdentityFunc("aws_kms_alias.test", identity1.Checks()),
-					querycheck.ExpectResourceDisplayName("aws_kms_alias.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), knownvalue.StringExact("alias/"+rName+"-0")),
+					querycheck.ExpectResourceDisplayName("aws_kms_alias.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), knownvalue.StringRegexp(regexache.MustCompile(`^alias/`+rName+`-0 \([0-9a-f-]{36}\)$`))),
 					querycheck.ExpectResourceKnownValues("aws_kms_alias.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), []querycheck.KnownValueCheck{
 						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrARN), knownvalue.NotNull()),
 						tfquerycheck.KnownValueCheck(tfjsonpath.New(names.AttrName), knownvalue.StringExact("alias/"+rName+"-0")),
@@ -324,33 +324,16 @@ type kmsKeyInfo struct {
 func findKeyInfo(ctx context.Context, conn *kms.Client, keyID string, isNewResource bool) (*kmsKeyInfo, error) {
 	// Wait for propagation since KMS is eventually consistent.
 	return tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func(ctx context.Context) (*kmsKeyInfo, error) {
-		var err error
-		var key kmsKeyInfo
-
-		key.metadata, err = findKeyByID(ctx, conn, keyID)
+		metadata, err := findKeyByID(ctx, conn, keyID)
 
 		if err != nil {
 			return nil, fmt.Errorf("reading KMS Key (%s): %w", keyID, err)
 		}
 
-		policy, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+		key, err := findKeyInfoByMetadata(ctx, conn, metadata)
 
 		if err != nil {
-			return nil, fmt.Errorf("reading KMS Key (%s) policy: %w", keyID, err)
-		}
-
-		key.policy, err = structure.NormalizeJsonString(aws.ToString(policy))
-
-		if err != nil {
-			return nil, fmt.Errorf("policy contains invalid JSON: %w", err)
-		}
-
-		if key.metadata.Origin == awstypes.OriginTypeAwsKms {
-			key.rotation, key.rotationPeriodInDays, err = findKeyRotationEnabledByKeyID(ctx, conn, keyID)
-
-			if err != nil {
-				return nil, fmt.Errorf("reading KMS Key (%s) rotation enabled: %w", keyID, err)
-			}
+			return nil, err
 		}
 
 		tags, err := listTags(ctx, conn, keyID)
@@ -365,10 +348,40 @@ func findKeyInfo(ctx context.Context, conn *kms.Client, keyID string, isNewResou
 
 		key.tags = svcTags(tags)
 
-		return &key, nil
+		return key, nil
 	}, isNewResource)
 }
 
+// findKeyInfoByMetadata returns the policy and rotation settings of the specified key. Tags are not read.
+func findKeyInfoByMetadata(ctx context.Context, conn *kms.Client, metadata *awstypes.KeyMetadata) (*kmsKeyInfo, error) {
+	keyID := aws.ToString(metadata.KeyId)
+	key := kmsKeyInfo{
+		metadata: metadata,
+	}
+
+	policy, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading KMS Key (%s) policy: %w", keyID, err)
+	}
+
+	key.policy, err = structure.NormalizeJsonString(aws.ToString(policy))
+
+	if err != nil {
+		return nil, fmt.Errorf("policy contains invalid JSON: %w", err)
+	}
+
+	if metadata.Origin == awstypes.OriginTypeAwsKms {
+		key.rotation, key.rotationPeriodInDays, err = findKeyRotationEnabledByKeyID(ctx, conn, keyID)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading KMS Key (%s) rotation enabled: %w", keyID, err)
+		}
+	}
+
+	return &key, nil
+}
+
 func findKeyByID(ctx context.Context, conn *kms.Client, keyID string, optFns ...func(*kms.Options)) (*awstypes.KeyMetadata, error) {
 	input := kms.DescribeKeyInput{
 		KeyId: aws.String(keyID),
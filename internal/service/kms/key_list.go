@@ -5,17 +5,23 @@ package kms
 
 import (
 	"context"
+	"fmt"
 	"iter"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,6 +39,17 @@ type keyListResource struct {
 
 type keyListResourceModel struct {
 	framework.WithRegionModel
+	ExcludeAWSManaged types.Bool `tfsdk:"exclude_aws_managed"`
+}
+
+func (l *keyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"exclude_aws_managed": listschema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
 }
 
 func (l *keyListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
@@ -49,71 +66,165 @@ func (l *keyListResource) List(ctx context.Context, request list.ListRequest, st
 
 	tflog.Info(ctx, "Listing KMS keys")
 	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := describeKeyRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		aliases, err := findKeyAliasNames(ctx, conn)
+		if err != nil {
+			result := fwdiag.NewListResultErrorDiagnostic(err)
+			yield(result)
+			return
+		}
+
 		var input kms.ListKeysInput
-		for item, err := range listKeys(ctx, conn, &input) {
+		for page, err := range listKeyPages(ctx, conn, &input) {
 			if err != nil {
 				result := fwdiag.NewListResultErrorDiagnostic(err)
 				yield(result)
 				return
 			}
 
-			id := aws.ToString(item.KeyId)
-			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+			// ListKeys returns only key IDs and ARNs, so each key is described before filtering.
+			keys := make([]*awstypes.KeyMetadata, 0, len(page))
+			for _, item := range page {
+				id := aws.ToString(item.KeyId)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
 
-			result := request.NewListResult(ctx)
-			rd := l.ResourceData()
-			rd.SetId(id)
+				// Keys aren't described once listing has been canceled.
+				if err := ctx.Err(); err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
 
-			key, err := findKeyInfo(ctx, conn, id, false)
-			if err != nil {
-				tflog.Error(ctx, "Reading KMS key", map[string]any{
-					names.AttrID: id,
-					"err":        err.Error(),
+				metadata, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (*awstypes.KeyMetadata, error) {
+					return findKeyByID(ctx, conn, id)
 				})
-				continue
-			}
+				if retry.NotFound(err) {
+					continue
+				}
+				if err != nil {
+					tflog.Error(ctx, "Reading KMS key", map[string]any{
+						names.AttrID: id,
+						"err":        err.Error(),
+					})
+					continue
+				}
 
-			diags := resourceKeyFlatten(ctx, rd, key)
-			if diags.HasError() || rd.Id() == "" {
-				// Resource can't be read or is logically deleted.
-				// Log and continue.
-				tflog.Error(ctx, "Reading KMS key", map[string]any{
-					names.AttrID: id,
-					"diags":      sdkdiag.DiagnosticsString(diags),
-				})
-				continue
+				if query.ExcludeAWSManaged.ValueBool() && metadata.KeyManager == awstypes.KeyManagerTypeAws {
+					continue
+				}
+
+				keys = append(keys, metadata)
 			}
 
-			result.DisplayName = id
+			// Tags are fetched for the whole page in as few Resource Groups Tagging API calls as possible
+			// rather than with one ListResourceTags call per key.
+			var tags map[string]map[string]string
+			if request.IncludeResource {
+				arns := make([]string, 0, len(keys))
+				for _, metadata := range keys {
+					arns = append(arns, aws.ToString(metadata.Arn))
+				}
 
-			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
-			if result.Diagnostics.HasError() {
-				yield(result)
-				return
+				tags, err = tftags.BatchFetchResourceTags(ctx, awsClient.ResourceGroupsTaggingAPIClient(ctx), awsClient.RateLimitScope(ctx), arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing KMS key tags: %w", err))
+					yield(result)
+					return
+				}
 			}
 
-			if !yield(result) {
-				return
+			for _, metadata := range keys {
+				id := aws.ToString(metadata.KeyId)
+				arn := aws.ToString(metadata.Arn)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+				result := request.NewListResult(ctx)
+				rd := l.ResourceData()
+				rd.SetId(id)
+
+				if request.IncludeResource {
+					key, err := findKeyInfoByMetadata(ctx, conn, metadata)
+					if err != nil {
+						tflog.Error(ctx, "Reading KMS key", map[string]any{
+							names.AttrID: id,
+							"err":        err.Error(),
+						})
+						continue
+					}
+					key.tags = svcTags(tftags.New(ctx, tags[arn]))
+
+					diags := resourceKeyFlatten(ctx, rd, key)
+					if diags.HasError() || rd.Id() == "" {
+						// Resource can't be read or is logically deleted.
+						// Log and continue.
+						tflog.Error(ctx, "Reading KMS key", map[string]any{
+							names.AttrID: id,
+							"diags":      sdkdiag.DiagnosticsString(diags),
+						})
+						continue
+					}
+				}
+
+				if v, ok := aliases[id]; ok {
+					result.DisplayName = v
+				} else {
+					result.DisplayName = arn
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
 			}
 		}
 	}
 }
 
-func listKeys(ctx context.Context, conn *kms.Client, input *kms.ListKeysInput) iter.Seq2[awstypes.KeyListEntry, error] {
-	return func(yield func(awstypes.KeyListEntry, error) bool) {
+func listKeyPages(ctx context.Context, conn *kms.Client, input *kms.ListKeysInput) iter.Seq2[[]awstypes.KeyListEntry, error] {
+	return func(yield func([]awstypes.KeyListEntry, error) bool) {
 		pages := kms.NewListKeysPaginator(conn, input)
 		for pages.HasMorePages() {
 			page, err := pages.NextPage(ctx)
 			if err != nil {
-				yield(awstypes.KeyListEntry{}, err)
+				yield(nil, err)
 				return
 			}
 
-			for _, key := range page.Keys {
-				if !yield(key, nil) {
-					return
-				}
+			if !yield(page.Keys, nil) {
+				return
 			}
 		}
 	}
 }
+
+// findKeyAliasNames returns the name of the first alias of each key in the Region, keyed by key ID.
+func findKeyAliasNames(ctx context.Context, conn *kms.Client) (map[string]string, error) {
+	aliasNames := make(map[string]string)
+
+	var input kms.ListAliasesInput
+	for alias, err := range listAliases(ctx, conn, &input) {
+		if err != nil {
+			return nil, err
+		}
+
+		keyID := aws.ToString(alias.TargetKeyId)
+		if keyID == "" {
+			continue
+		}
+		if _, ok := aliasNames[keyID]; !ok {
+			aliasNames[keyID] = aws.ToString(alias.AliasName)
+		}
+	}
+
+	return aliasNames, nil
+}
+
+// describeKeyRateLimiters limit the DescribeKey calls made to filter and hydrate listed keys.
+// DescribeKey shares its request quota with cryptographic operations, so keys are described at no more than 20 per second by default.
+var describeKeyRateLimiters = ratelimit.Register("kms:DescribeKey", 20, 20)
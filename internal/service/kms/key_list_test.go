@@ -170,3 +170,46 @@ func TestAccKMSKey_List_regionOverride(t *testing.T) {
 		},
 	})
 }
+
+func TestAccKMSKey_List_excludeAWSManaged(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kms_key.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	identity := tfstatecheck.Identity()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
+		CheckDestroy: testAccCheckKeyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Key/list_exclude_aws_managed/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName: config.StringVariable(rName),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					identity.GetIdentity(resourceName),
+				},
+			},
+
+			// Step 2: Query
+			{
+				Query:                    true,
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Key/list_exclude_aws_managed/"),
+				ConfigVariables: config.Variables{
+					acctest.CtRName: config.StringVariable(rName),
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					tfquerycheck.ExpectIdentityFunc("aws_kms_key.test", identity.Checks()),
+					querycheck.ExpectResourceDisplayName("aws_kms_key.test", tfqueryfilter.ByResourceIdentityFunc(identity.Checks()), knownvalue.StringExact("alias/"+rName)),
+				},
+			},
+		},
+	})
+}
@@ -3,4 +3,8 @@
 
 list "aws_kms_alias" "test" {
   provider = aws
+
+  config {
+    customer_managed_only = true
+  }
 }
@@ -33,16 +33,15 @@ import (
 const mutexLayerKey = `aws_lambda_layer_version`
 
 // @SDKResource("aws_lambda_layer_version", name="Layer Version")
+// @ArnIdentity
+// @Testing(importIgnore="filename;skip_destroy")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceLayerVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLayerVersionCreate,
 		ReadWithoutTimeout:   resourceLayerVersionRead,
 		DeleteWithoutTimeout: resourceLayerVersionDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -240,6 +239,13 @@ func resourceLayerVersionRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "reading Lambda Layer Version (%s): %s", d.Id(), err)
 	}
 
+	resourceLayerVersionFlatten(ctx, output, layerName, d)
+	d.Set("source_code_hash", d.Get("source_code_hash"))
+
+	return diags
+}
+
+func resourceLayerVersionFlatten(_ context.Context, output *lambda.GetLayerVersionOutput, layerName string, d *schema.ResourceData) {
 	d.Set(names.AttrARN, output.LayerVersionArn)
 	d.Set("code_sha256", output.Content.CodeSha256)
 	d.Set("compatible_architectures", output.CompatibleArchitectures)
@@ -251,11 +257,8 @@ func resourceLayerVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("license_info", output.LicenseInfo)
 	d.Set("signing_job_arn", output.Content.SigningJobArn)
 	d.Set("signing_profile_version_arn", output.Content.SigningProfileVersionArn)
-	d.Set("source_code_hash", d.Get("source_code_hash"))
 	d.Set("source_code_size", output.Content.CodeSize)
-	d.Set(names.AttrVersion, strconv.FormatInt(versionNumber, 10))
-
-	return diags
+	d.Set(names.AttrVersion, strconv.FormatInt(output.Version, 10))
 }
 
 func resourceLayerVersionDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
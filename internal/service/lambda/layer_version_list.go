@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_lambda_layer_version")
+func newLayerVersionResourceAsListResource() inttypes.ListResourceForSDK {
+	l := layerVersionListResource{}
+	l.SetResourceSchema(resourceLayerVersion())
+
+	return &l
+}
+
+var _ list.ListResource = &layerVersionListResource{}
+
+type layerVersionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type layerVersionListResourceModel struct {
+	framework.WithRegionModel
+	AllVersions types.Bool `tfsdk:"all_versions"`
+}
+
+func (l *layerVersionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"all_versions": listschema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *layerVersionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	var query layerVersionListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing Lambda Layer Versions")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for layerVersion, err := range listLayerVersions(ctx, conn, query.AllVersions.ValueBool()) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			arn := aws.ToString(layerVersion.LayerVersionArn)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+			layerName, versionNumber, err := layerVersionParseResourceID(arn)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(arn)
+			rd.Set(names.AttrARN, arn)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading Lambda Layer Version")
+				// The list items don't include the layer content, so each version is read in full.
+				// Layer versions don't support tags.
+				output, err := findLayerVersionByTwoPartKey(ctx, conn, layerName, versionNumber)
+				if err != nil {
+					tflog.Error(ctx, "Reading Lambda Layer Version", map[string]any{
+						"error": err.Error(),
+					})
+					continue
+				}
+
+				resourceLayerVersionFlatten(ctx, output, layerName, rd)
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%d)", layerName, versionNumber)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// listLayerVersions returns the latest version of each layer or, if allVersions is set, every version of each layer.
+func listLayerVersions(ctx context.Context, conn *lambda.Client, allVersions bool) iter.Seq2[awstypes.LayerVersionsListItem, error] {
+	return func(yield func(awstypes.LayerVersionsListItem, error) bool) {
+		pages := lambda.NewListLayersPaginator(conn, &lambda.ListLayersInput{})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.LayerVersionsListItem{}, fmt.Errorf("listing Lambda Layers: %w", err))
+				return
+			}
+
+			for _, layer := range page.Layers {
+				if !allVersions {
+					if layer.LatestMatchingVersion == nil {
+						continue
+					}
+
+					if !yield(*layer.LatestMatchingVersion, nil) {
+						return
+					}
+					continue
+				}
+
+				input := lambda.ListLayerVersionsInput{
+					LayerName: layer.LayerName,
+				}
+				pages := lambda.NewListLayerVersionsPaginator(conn, &input)
+				for pages.HasMorePages() {
+					page, err := pages.NextPage(ctx)
+					if err != nil {
+						yield(awstypes.LayerVersionsListItem{}, fmt.Errorf("listing Lambda Layer (%s) Versions: %w", aws.ToString(layer.LayerName), err))
+						return
+					}
+
+					for _, layerVersion := range page.LayerVersions {
+						if !yield(layerVersion, nil) {
+							return
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -202,6 +202,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_lambda_layer_version",
 			Name:     "Layer Version",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalARNIdentity(
+				inttypes.WithIdentityDuplicateAttrs(names.AttrID),
+			),
+			Import: inttypes.SDKv2Import{
+				WrappedImport: true,
+			},
 		},
 		{
 			Factory:  resourceLayerVersionPermission,
@@ -245,6 +251,13 @@ func (p *servicePackage) SDKListResources(ctx context.Context) iter.Seq[*inttype
 			}),
 			Identity: inttypes.RegionalSingleParameterIdentity("function_name"),
 		},
+		{
+			Factory:  newLayerVersionResourceAsListResource,
+			TypeName: "aws_lambda_layer_version",
+			Name:     "Layer Version",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalARNIdentity(),
+		},
 		{
 			Factory:  permissionResourceAsListResource,
 			TypeName: "aws_lambda_permission",
@@ -30,15 +30,16 @@ import (
 
 // @SDKResource("aws_lightsail_instance", name="Instance")
 // @Tags(identifierAttribute="id", resourceType="Instance")
+// @IdentityAttribute("name")
+// @Testing(idAttrDuplicates="name")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="v6.34.0")
 func ResourceInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInstanceCreate,
 		ReadWithoutTimeout:   resourceInstanceRead,
 		UpdateWithoutTimeout: resourceInstanceUpdate,
 		DeleteWithoutTimeout: resourceInstanceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 
 		Schema: map[string]*schema.Schema{
 			"add_on": {
@@ -251,6 +252,12 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResInstance, d.Id(), err)
 	}
 
+	resourceInstanceFlatten(ctx, d, out)
+
+	return diags
+}
+
+func resourceInstanceFlatten(ctx context.Context, d *schema.ResourceData, out *types.Instance) {
 	d.Set("add_on", flattenAddOns(out.AddOns))
 	d.Set(names.AttrAvailabilityZone, out.Location.AvailabilityZone)
 	d.Set("blueprint_id", out.BlueprintId)
@@ -272,8 +279,6 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 	d.Set("public_ip_address", out.PublicIpAddress)
 
 	setTagsOut(ctx, out.Tags)
-
-	return diags
 }
 
 func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
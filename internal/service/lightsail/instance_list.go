@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_lightsail_instance")
+func newInstanceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := instanceListResource{}
+	l.SetResourceSchema(ResourceInstance())
+
+	return &l
+}
+
+var _ list.ListResource = &instanceListResource{}
+
+type instanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type instanceListResourceModel struct {
+	framework.WithRegionModel
+	BlueprintID types.String `tfsdk:"blueprint_id"`
+}
+
+func (l *instanceListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"blueprint_id": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.LightsailClient(ctx)
+
+	var query instanceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	filter := instanceBlueprintIDFilter(query.BlueprintID)
+
+	tflog.Info(ctx, "Listing Lightsail Instances")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input lightsail.GetInstancesInput
+		for instance, err := range listInstances(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if !filter(&instance) {
+				continue
+			}
+
+			name := aws.ToString(instance.Name)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), name)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(name)
+			rd.Set(names.AttrName, name)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading Lightsail Instance")
+				// GetInstances returns the same instance attributes and tags as GetInstance.
+				resourceInstanceFlatten(ctx, rd, &instance)
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%s)", name, aws.ToString(instance.BundleId))
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// instanceBlueprintIDFilter returns a predicate selecting instances created from the specified blueprint.
+// A null value selects all instances.
+func instanceBlueprintIDFilter(blueprintID types.String) tfslices.Predicate[*awstypes.Instance] {
+	if blueprintID.IsNull() || blueprintID.IsUnknown() {
+		return tfslices.PredicateTrue[*awstypes.Instance]()
+	}
+
+	return func(v *awstypes.Instance) bool {
+		return aws.ToString(v.BlueprintId) == blueprintID.ValueString()
+	}
+}
+
+func listInstances(ctx context.Context, conn *lightsail.Client, input *lightsail.GetInstancesInput) iter.Seq2[awstypes.Instance, error] {
+	return func(yield func(awstypes.Instance, error) bool) {
+		for {
+			output, err := conn.GetInstances(ctx, input)
+			if err != nil {
+				yield(awstypes.Instance{}, fmt.Errorf("listing Lightsail Instances: %w", err))
+				return
+			}
+
+			for _, instance := range output.Instances {
+				if !yield(instance, nil) {
+					return
+				}
+			}
+
+			if aws.ToString(output.NextPageToken) == "" {
+				return
+			}
+
+			input.PageToken = output.NextPageToken
+		}
+	}
+}
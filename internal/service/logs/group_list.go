@@ -5,17 +5,42 @@ package logs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKListResource("aws_cloudwatch_log_group")
@@ -32,12 +57,207 @@ type logGroupListResource struct {
 
 type logGroupListResourceModel struct {
 	framework.WithRegionModel
+	framework.WithAccountIDsModel
+	framework.WithAllRegionsModel
+	framework.WithCSVExportModel
+	framework.WithDisplayNameTemplateModel
+	framework.WithExportModel
+	framework.WithImportBlocksModel
+	framework.WithNameRegexModel
+	framework.WithStartTokenModel
+	CreatedAfter          timetypes.RFC3339 `tfsdk:"created_after"`
+	CreatedBefore         timetypes.RFC3339 `tfsdk:"created_before"`
+	ExcludeAWSManaged     types.Bool        `tfsdk:"exclude_aws_managed"`
+	IncludeMetrics        types.Bool        `tfsdk:"include_metrics"`
+	MaxConcurrency        types.Int64       `tfsdk:"max_concurrency"`
+	NamePrefix            types.String      `tfsdk:"name_prefix"`
+	NoMetricFilters       types.Bool        `tfsdk:"no_metric_filters"`
+	NoRetention           types.Bool        `tfsdk:"no_retention"`
+	NoSubscriptionFilters types.Bool        `tfsdk:"no_subscription_filters"`
+	PageSize              types.Int64       `tfsdk:"page_size"`
+	RetentionInDays       types.Int64       `tfsdk:"retention_in_days"`
+	StoredBytesGT         types.Int64       `tfsdk:"stored_bytes_gt"`
+	Tags                  types.Map         `tfsdk:"tags"`
+	UnencryptedOnly       types.Bool        `tfsdk:"unencrypted_only"`
 }
 
-func (l *logGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
-	awsClient := l.Meta()
-	conn := awsClient.LogsClient(ctx)
+const (
+	// Names of log groups created by AWS services start with this prefix.
+	awsManagedLogGroupNamePrefix = "/aws/"
+)
+
+// predicate returns a predicate that is true for the listed log groups that match the query.
+func (m logGroupListResourceModel) predicate() (tfslices.Predicate[*awstypes.LogGroup], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	nameFilter, d := m.NamePredicate()
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	predicates := []tfslices.Predicate[*awstypes.LogGroup]{
+		func(v *awstypes.LogGroup) bool {
+			return nameFilter(aws.ToString(v.LogGroupName))
+		},
+	}
+
+	// Log groups created by AWS services on the account's behalf are named /aws/<service>/...
+	if m.ExcludeAWSManaged.ValueBool() {
+		predicates = append(predicates, func(v *awstypes.LogGroup) bool {
+			return !strings.HasPrefix(aws.ToString(v.LogGroupName), awsManagedLogGroupNamePrefix)
+		})
+	}
+
+	// CreationTime is in milliseconds since the epoch.
+	if !m.CreatedAfter.IsNull() {
+		t, d := m.CreatedAfter.ValueRFC3339Time()
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		predicates = append(predicates, func(v *awstypes.LogGroup) bool {
+			return v.CreationTime != nil && aws.ToInt64(v.CreationTime) > t.UnixMilli()
+		})
+	}
+	if !m.CreatedBefore.IsNull() {
+		t, d := m.CreatedBefore.ValueRFC3339Time()
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		predicates = append(predicates, func(v *awstypes.LogGroup) bool {
+			return v.CreationTime != nil && aws.ToInt64(v.CreationTime) < t.UnixMilli()
+		})
+	}
+
+	// A nil RetentionInDays means that log events never expire.
+	if m.NoRetention.ValueBool() {
+		predicates = append(predicates, func(v *awstypes.LogGroup) bool {
+			return v.RetentionInDays == nil
+		})
+	}
+	if !m.RetentionInDays.IsNull() {
+		retentionInDays := int32(m.RetentionInDays.ValueInt64())
+		predicates = append(predicates, func(v *awstypes.LogGroup) bool {
+			return aws.ToInt32(v.RetentionInDays) == retentionInDays
+		})
+	}
+
+	// Log groups that aren't encrypted with a customer managed KMS key have no KmsKeyId.
+	if m.UnencryptedOnly.ValueBool() {
+		predicates = append(predicates, func(v *awstypes.LogGroup) bool {
+			return v.KmsKeyId == nil
+		})
+	}
+
+	// A nil StoredBytes is treated as no bytes stored.
+	if !m.StoredBytesGT.IsNull() {
+		storedBytesGT := m.StoredBytesGT.ValueInt64()
+		predicates = append(predicates, func(v *awstypes.LogGroup) bool {
+			return aws.ToInt64(v.StoredBytes) > storedBytesGT
+		})
+	}
+
+	return tfslices.PredicateAnd(predicates...), diags
+}
+
+// pageSize returns the maximum number of log groups that DescribeLogGroups is to return in each page.
+// Pages are no larger than a non-zero limit on the number of results.
+func (m logGroupListResourceModel) pageSize(limit int64) int32 {
+	pageSize := int64(describeLogGroupsMaxLimit)
+	if !m.PageSize.IsNull() {
+		pageSize = m.PageSize.ValueInt64()
+	}
+	if limit > 0 {
+		pageSize = min(pageSize, limit)
+	}
+
+	return int32(pageSize)
+}
 
+func (l *logGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"account_ids":      framework.AccountIDsAttribute(),
+			"all_regions":      framework.AllRegionsAttribute(),
+			"assume_role_name": framework.AssumeRoleNameAttribute(),
+			"created_after": listschema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			"created_before": listschema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			"csv_export_path":       framework.CSVExportPathAttribute(),
+			"display_name_template": framework.DisplayNameTemplateAttribute(),
+			"exclude_aws_managed": listschema.BoolAttribute{
+				Optional: true,
+			},
+			"export_path":            framework.ExportPathAttribute(),
+			"generate_import_blocks": framework.GenerateImportBlocksAttribute(),
+			"import_blocks_path":     framework.ImportBlocksPathAttribute(),
+			"include_metrics": listschema.BoolAttribute{
+				Optional: true,
+			},
+			"max_concurrency": listschema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional: true,
+			},
+			"name_regex": framework.NameRegexAttribute(),
+			"no_metric_filters": listschema.BoolAttribute{
+				Optional: true,
+			},
+			"no_retention": listschema.BoolAttribute{
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("retention_in_days")),
+				},
+			},
+			"no_subscription_filters": listschema.BoolAttribute{
+				Optional: true,
+			},
+			"page_size": listschema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, describeLogGroupsMaxLimit),
+				},
+			},
+			"retention_in_days": listschema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			// Tokens are only valid for DescribeLogGroups in a single account and Region.
+			"start_token": framework.StartTokenAttribute(
+				path.MatchRoot("account_ids"),
+				path.MatchRoot("all_regions"),
+				path.MatchRoot(names.AttrTags),
+			),
+			"stored_bytes_gt": listschema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"unencrypted_only": listschema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *logGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
 	var query logGroupListResourceModel
 	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
 		if diags := request.Config.Get(ctx, &query); diags.HasError() {
@@ -46,31 +266,563 @@ func (l *logGroupListResource) List(ctx context.Context, request list.ListReques
 		}
 	}
 
-	stream.Results = func(yield func(list.ListResult) bool) {
-		result := request.NewListResult(ctx)
-		var input cloudwatchlogs.DescribeLogGroupsInput
-		for output, err := range listLogGroups(ctx, conn, &input, tfslices.PredicateTrue[*awstypes.LogGroup]()) {
-			if err != nil {
-				result = fwdiag.NewListResultErrorDiagnostic(err)
-				yield(result)
+	filter, diags := query.predicate()
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	displayNameTemplate, diags := query.ParseDisplayNameTemplate()
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var tags map[string]string
+	if !query.Tags.IsNull() {
+		if diags := query.Tags.ElementsAs(ctx, &tags, false); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	accountIDs, diags := query.AccountIDsValue(ctx)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	export, diags := query.OpenExport()
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	csvExport, diags := query.OpenCSVExport()
+	if diags.HasError() {
+		_ = export.Close()
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	importBlocks := query.NewImportBlocks("aws_cloudwatch_log_group")
+
+	listInAccount := func(ctx context.Context, awsClient *conns.AWSClient) iter.Seq[list.ListResult] {
+		listInRegion := func(ctx context.Context) iter.Seq[list.ListResult] {
+			return l.listInRegion(ctx, awsClient, request, query, filter, tags, displayNameTemplate, export, csvExport, importBlocks)
+		}
+		if query.AllRegions.ValueBool() {
+			return framework.ListInAllRegions(ctx, awsClient, listInRegion)
+		}
+		return listInRegion(ctx)
+	}
+	if len(accountIDs) > 0 {
+		stream.Results = framework.ListInAccounts(ctx, l.Meta(), accountIDs, query.AssumeRoleName.ValueString(), listInAccount)
+	} else {
+		stream.Results = listInAccount(ctx, l.Meta())
+	}
+	// Each Region and account is listed with the request's limit, which is then applied to them all together.
+	stream.Results = framework.LimitListResults(stream.Results, request.Limit)
+	stream.Results = importBlocks.WriteAfter(stream.Results)
+	stream.Results = export.CloseAfter(stream.Results)
+	stream.Results = csvExport.CloseAfter(stream.Results)
+}
+
+// listInRegion returns an iterator over the results of listing log groups, with awsClient, in the Region in which ctx makes calls.
+func (l *logGroupListResource) listInRegion(ctx context.Context, awsClient *conns.AWSClient, request list.ListRequest, query logGroupListResourceModel, filter tfslices.Predicate[*awstypes.LogGroup], tags map[string]string, displayNameTemplate *template.Template, export *framework.Export, csvExport *framework.CSVExport, importBlocks *framework.ImportBlocks) iter.Seq[list.ListResult] {
+	conn := awsClient.LogsClient(ctx)
+
+	return func(yield func(list.ListResult) bool) {
+		limiter := rateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		metrics := newLogGroupListMetrics()
+		// Progress is reported to the function set with WithListProgressFunc, if any.
+		metrics.progress, metrics.region = listProgressFunc(ctx), awsClient.Region(ctx)
+
+		// Throttled calls are counted by the shared rate limiters, so include any made concurrently by other list resources.
+		throttles := func() int64 {
+			return limiter.Throttles() + tftags.GetResourcesThrottles(awsClient.RateLimitScope(ctx))
+		}
+		initialThrottles := throttles()
+		recordThrottles := sync.OnceFunc(func() {
+			metrics.throttled(throttles() - initialThrottles)
+		})
+		defer func() {
+			recordThrottles()
+			metrics.log(ctx)
+		}()
+
+		// Pages are listed with the context passed by listLogGroupPagesWithTags, so that listing stops as soon as iteration does.
+		listPages := func(ctx context.Context) iter.Seq[logGroupPage] {
+			var pages iter.Seq[logGroupPage]
+			if len(tags) > 0 {
+				// Only log groups with all of the tags are listed, and their tags are already known.
+				pages = listTaggedLogGroupPages(ctx, conn, awsClient.ResourceGroupsTaggingAPIClient(ctx), awsClient.RateLimitScope(ctx), limiter, tags, query.NamePrefix.ValueString())
+			} else {
+				input := cloudwatchlogs.DescribeLogGroupsInput{
+					LogGroupNamePrefix: fwflex.StringFromFramework(ctx, query.NamePrefix),
+					Limit:              aws.Int32(query.pageSize(request.Limit)),
+					NextToken:          fwflex.StringFromFramework(ctx, query.StartToken),
+				}
+				pages = listLogGroupPages(ctx, conn, &input, limiter)
+			}
+			// Log groups created while listing can be returned on more than one page.
+			pages = dedupeLogGroupPages(pages, logGroupDedupeMaxSize)
+			// Log groups are filtered before their tags are fetched.
+			pages = filterLogGroupPages(pages, filter)
+			// Each check for metric or subscription filters is a call per log group, so only the log groups that match the other filters are checked.
+			if query.NoMetricFilters.ValueBool() || query.NoSubscriptionFilters.ValueBool() {
+				pages = filterUnprocessedLogGroupPages(ctx, conn, limiter, pages, query.NoMetricFilters.ValueBool(), query.NoSubscriptionFilters.ValueBool())
+			}
+			return pages
+		}
+
+		// Tags are fetched for the whole page in as few Resource Groups Tagging API calls as possible
+		// rather than with one ListTagsForResource call per log group.
+		var fetchTags func(context.Context, []string) (map[string]map[string]string, map[string]error, error)
+		if request.IncludeResource && len(tags) == 0 {
+			fetchTags = func(ctx context.Context, arns []string) (map[string]map[string]string, map[string]error, error) {
+				defer metrics.fetchedTags(time.Now())
+
+				tags, err := tftags.BatchFetchResourceTags(ctx, awsClient.ResourceGroupsTaggingAPIClient(ctx), awsClient.RateLimitScope(ctx), arns)
+				if err != nil {
+					if isFatalTagsError(err) {
+						return nil, nil, err
+					}
+
+					// The page's tags are read one log group at a time instead.
+					tflog.Warn(ctx, "Batch fetching CloudWatch Logs Log Group tags", map[string]any{
+						"error": err.Error(),
+					})
+					tags = make(map[string]map[string]string, len(arns))
+				}
+
+				return fetchMissingLogGroupTags(ctx, conn, limiter, arns, tags)
+			}
+		}
+
+		maxConcurrency := 1
+		if !query.MaxConcurrency.IsNull() {
+			maxConcurrency = int(query.MaxConcurrency.ValueInt64())
+		}
+
+		for page := range listLogGroupPagesWithTags(ctx, listPages, request.Limit, fetchTags, maxConcurrency) {
+			if page.err != nil {
+				yield(framework.NewResumableListResultErrorDiagnostic(&framework.StartTokenError{
+					Err:        page.err,
+					StartToken: page.token,
+				}))
+				return
+			}
+			metrics.page(ctx, len(page.logGroups))
+
+			for _, output := range page.logGroups {
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(aws.ToString(output.LogGroupName))
+				resourceGroupFlatten(ctx, rd, output)
+
+				if request.IncludeResource {
+					arn := trimLogGroupARNWildcardSuffix(aws.ToString(output.Arn))
+					setTagsOut(ctx, svcTags(tftags.New(ctx, page.tags[arn])))
+
+					// The log group is still listed, but without its tags.
+					if err, ok := page.tagErrs[arn]; ok {
+						result.Diagnostics.AddWarning(
+							"Error listing CloudWatch Logs Log Group tags",
+							fmt.Sprintf("Tags could not be fetched for CloudWatch Logs Log Group (%s), so it is listed without tags: %s", aws.ToString(output.LogGroupName), err),
+						)
+					}
+				}
+
+				// Stored bytes aren't an attribute of the resource, so they're shown in the default display name
+				// when log groups are filtered on them.
+				defaultName := aws.ToString(output.LogGroupName)
+				if !query.StoredBytesGT.IsNull() {
+					defaultName = fmt.Sprintf("%s (%d bytes)", defaultName, aws.ToInt64(output.StoredBytes))
+				}
+
+				displayName, err := l.DisplayName(displayNameTemplate, rd, awsClient.Region(ctx), defaultName)
+				if err != nil {
+					yield(fwdiag.NewListResultErrorDiagnostic(err))
+					return
+				}
+				result.DisplayName = displayName
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				record := l.ExportRecord(rd, awsClient.Region(ctx), displayName)
+				record.Attributes["stored_bytes"] = aws.ToInt64(output.StoredBytes)
+				export.Add(record)
+				csvExport.Add(record)
+				importBlocks.Add(aws.ToString(output.LogGroupName), rd.Id(), awsClient.Region(ctx))
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+
+		if query.IncludeMetrics.ValueBool() {
+			recordThrottles()
+			yield(newLogGroupListMetricsResult(metrics.summary(awsClient.Region(ctx))))
+		}
+	}
+}
+
+// logGroupPage is a page of listed log groups, along with their tags if requested.
+type logGroupPage struct {
+	logGroups []awstypes.LogGroup
+	tags      map[string]map[string]string
+	tagErrs   map[string]error // Errors fetching the tags of individual log groups, keyed by ARN.
+	token     string           // The pagination token with which the page was requested, from which listing can be resumed if the page fails.
+	err       error
+}
+
+// listLogGroupPagesWithTags returns an iterator over the pages of log groups returned by listPages,
+// up to limit log groups in total if limit is positive.
+// If fetchTags is not nil, each page is given the tags of its log groups, keyed by ARN,
+// along with the errors fetching the tags of individual log groups that don't prevent listing.
+// Listing and tag fetching are pipelined: pages are listed in a separate goroutine and passed to the tag fetcher over a channel,
+// so that the next page is already being listed while the current page's tags are being fetched.
+// Pages are listed, and tags are fetched for up to maxConcurrency pages at once, with a context that is cancelled if iteration stops early.
+// Errors are returned in the final page.
+func listLogGroupPagesWithTags(ctx context.Context, listPages func(context.Context) iter.Seq[logGroupPage], limit int64, fetchTags func(context.Context, []string) (map[string]map[string]string, map[string]error, error), maxConcurrency int) iter.Seq[logGroupPage] {
+	pages := listPages
+	if limit > 0 {
+		pages = func(ctx context.Context) iter.Seq[logGroupPage] {
+			return limitLogGroupPages(listPages(ctx), limit)
+		}
+	}
+
+	if fetchTags == nil {
+		return pages(ctx)
+	}
+
+	return tfiter.MappedConcurrently(ctx, pages, maxConcurrency, func(ctx context.Context, page logGroupPage) logGroupPage {
+		if page.err != nil {
+			return page
+		}
+
+		arns := make([]string, 0, len(page.logGroups))
+		for _, output := range page.logGroups {
+			arns = append(arns, trimLogGroupARNWildcardSuffix(aws.ToString(output.Arn)))
+		}
+
+		tags, tagErrs, err := fetchTags(ctx, arns)
+		if err != nil {
+			page.err = fmt.Errorf("listing CloudWatch Logs Log Group tags: %w", err)
+		}
+		page.tags = tags
+		page.tagErrs = tagErrs
+
+		return page
+	})
+}
+
+// filterLogGroupPages removes the log groups that don't match filter from pages.
+func filterLogGroupPages(pages iter.Seq[logGroupPage], filter tfslices.Predicate[*awstypes.LogGroup]) iter.Seq[logGroupPage] {
+	return func(yield func(logGroupPage) bool) {
+		for page := range pages {
+			page.logGroups = tfslices.Filter(page.logGroups, tfslices.PredicateValue(filter))
+
+			if !yield(page) || page.err != nil {
+				return
+			}
+		}
+	}
+}
+
+// filterUnprocessedLogGroupPages removes from pages the log groups that have metric filters, if noMetricFilters is true,
+// or subscription filters, if noSubscriptionFilters is true.
+// Log groups are checked one at a time, with DescribeMetricFilters and DescribeSubscriptionFilters calls that wait on limiter.
+// An error checking any log group fails its page.
+func filterUnprocessedLogGroupPages(ctx context.Context, conn logGroupFiltersAPIClient, limiter *ratelimit.Limiter, pages iter.Seq[logGroupPage], noMetricFilters, noSubscriptionFilters bool) iter.Seq[logGroupPage] {
+	return func(yield func(logGroupPage) bool) {
+		for page := range pages {
+			if page.err == nil {
+				var logGroups []awstypes.LogGroup
+				for _, v := range page.logGroups {
+					unprocessed, err := isUnprocessedLogGroup(ctx, conn, limiter, aws.ToString(v.LogGroupName), noMetricFilters, noSubscriptionFilters)
+					if err != nil {
+						logGroups, page.err = nil, err
+						break
+					}
+					if unprocessed {
+						logGroups = append(logGroups, v)
+					}
+				}
+				page.logGroups = logGroups
+			}
+
+			if !yield(page) || page.err != nil {
+				return
+			}
+		}
+	}
+}
+
+// isUnprocessedLogGroup returns whether the named log group has no metric filters, if noMetricFilters is true,
+// and no subscription filters, if noSubscriptionFilters is true.
+// Log groups deleted since they were listed aren't unprocessed.
+func isUnprocessedLogGroup(ctx context.Context, conn logGroupFiltersAPIClient, limiter *ratelimit.Limiter, name string, noMetricFilters, noSubscriptionFilters bool) (bool, error) {
+	// A single filter is enough to show that the log group has some.
+	if noMetricFilters {
+		output, err := ratelimit.RetryThrottled(ctx, func(ctx context.Context) (*cloudwatchlogs.DescribeMetricFiltersOutput, error) {
+			if err := limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+
+			input := cloudwatchlogs.DescribeMetricFiltersInput{
+				Limit:        aws.Int32(1),
+				LogGroupName: aws.String(name),
+			}
+			return conn.DescribeMetricFilters(ctx, &input, observeThrottling(limiter))
+		})
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("reading CloudWatch Logs Log Group (%s) metric filters: %w", name, err)
+		}
+		if len(output.MetricFilters) > 0 {
+			return false, nil
+		}
+	}
+
+	if noSubscriptionFilters {
+		output, err := ratelimit.RetryThrottled(ctx, func(ctx context.Context) (*cloudwatchlogs.DescribeSubscriptionFiltersOutput, error) {
+			if err := limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+
+			input := cloudwatchlogs.DescribeSubscriptionFiltersInput{
+				Limit:        aws.Int32(1),
+				LogGroupName: aws.String(name),
+			}
+			return conn.DescribeSubscriptionFilters(ctx, &input, observeThrottling(limiter))
+		})
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("reading CloudWatch Logs Log Group (%s) subscription filters: %w", name, err)
+		}
+		if len(output.SubscriptionFilters) > 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+type logGroupFiltersAPIClient interface {
+	cloudwatchlogs.DescribeMetricFiltersAPIClient
+	cloudwatchlogs.DescribeSubscriptionFiltersAPIClient
+}
+
+const (
+	// At most this many listed log group names are remembered to skip duplicates.
+	logGroupDedupeMaxSize = 10000
+)
+
+// dedupeLogGroupPages removes from pages the log groups that have already been returned on a previous page.
+// Only the names of the most recently returned maxSize log groups are remembered,
+// which is enough for duplicates caused by log groups being created while listing, as they're returned on adjacent pages.
+// maxSize must be positive.
+func dedupeLogGroupPages(pages iter.Seq[logGroupPage], maxSize int) iter.Seq[logGroupPage] {
+	return func(yield func(logGroupPage) bool) {
+		seen := make(map[string]struct{})
+		// Names in seen, used as a ring buffer once full so that the oldest name is overwritten in place.
+		order := make([]string, 0, maxSize)
+		var oldest int // Index in order of the oldest name, once order is full.
+
+		for page := range pages {
+			page.logGroups = slices.DeleteFunc(page.logGroups, func(v awstypes.LogGroup) bool {
+				name := aws.ToString(v.LogGroupName)
+				if _, ok := seen[name]; ok {
+					return true
+				}
+
+				seen[name] = struct{}{}
+				if len(order) < maxSize {
+					order = append(order, name)
+				} else {
+					delete(seen, order[oldest])
+					order[oldest] = name
+					oldest = (oldest + 1) % maxSize
+				}
+
+				return false
+			})
+
+			if !yield(page) || page.err != nil {
+				return
+			}
+		}
+	}
+}
+
+// fetchMissingLogGroupTags adds to tags the tags of the log groups in arns that have none in tags,
+// read one log group at a time with ListTagsForResource.
+// The Resource Groups Tagging API is eventually consistent, so recently created or tagged log groups
+// may be missing from its results, as are untagged log groups.
+// Errors reading the tags of individual log groups are returned keyed by ARN, unless they are fatal.
+func fetchMissingLogGroupTags(ctx context.Context, conn listTagsForResourceAPIClient, limiter *ratelimit.Limiter, arns []string, tags map[string]map[string]string) (map[string]map[string]string, map[string]error, error) {
+	var tagErrs map[string]error
+
+	for _, arn := range arns {
+		if len(tags[arn]) > 0 {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, nil, err
+		}
+
+		input := cloudwatchlogs.ListTagsForResourceInput{
+			ResourceArn: aws.String(arn),
+		}
+		output, err := conn.ListTagsForResource(ctx, &input, observeThrottling(limiter))
+
+		if err != nil {
+			err = fmt.Errorf("listing tags for CloudWatch Logs Log Group (%s): %w", arn, err)
+			if isFatalTagsError(err) {
+				return nil, nil, err
+			}
+
+			if tagErrs == nil {
+				tagErrs = make(map[string]error)
+			}
+			tagErrs[arn] = err
+			continue
+		}
+
+		tags[arn] = output.Tags
+	}
+
+	return tags, tagErrs, nil
+}
+
+// isFatalTagsError returns whether the specified error fetching tags should abort listing,
+// rather than the affected log groups being listed without tags.
+// Credential and permission errors, and cancellation, affect every subsequent call too.
+func isFatalTagsError(err error) bool {
+	return errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		tfawserr.ErrCodeContains(err, "AccessDenied") ||
+		tfawserr.ErrCodeEquals(err, "ExpiredTokenException", "InvalidClientTokenId", "UnauthorizedOperation", "UnrecognizedClientException")
+}
+
+type listTagsForResourceAPIClient interface {
+	ListTagsForResource(context.Context, *cloudwatchlogs.ListTagsForResourceInput, ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.ListTagsForResourceOutput, error)
+}
+
+// limitLogGroupPages truncates pages to limit log groups in total.
+func limitLogGroupPages(pages iter.Seq[logGroupPage], limit int64) iter.Seq[logGroupPage] {
+	return func(yield func(logGroupPage) bool) {
+		var count int64
+		for page := range pages {
+			if int64(len(page.logGroups)) > limit-count {
+				page.logGroups = page.logGroups[:limit-count]
+			}
+			count += int64(len(page.logGroups))
+
+			if !yield(page) || page.err != nil {
 				return
 			}
 
-			rd := l.ResourceData()
-			rd.SetId(aws.ToString(output.LogGroupName))
-			resourceGroupFlatten(ctx, rd, output)
+			// Stop before the next page is requested.
+			if count >= limit {
+				return
+			}
+		}
+	}
+}
+
+const (
+	// The maximum number of log groups that DescribeLogGroups returns in a single page.
+	describeLogGroupsMaxLimit = 50
+)
+
+// listLogGroupPages returns an iterator over pages of log groups.
+// Errors are returned in the final page.
+func listLogGroupPages(ctx context.Context, conn cloudwatchlogs.DescribeLogGroupsAPIClient, input *cloudwatchlogs.DescribeLogGroupsInput, limiter *ratelimit.Limiter) iter.Seq[logGroupPage] {
+	return func(yield func(logGroupPage) bool) {
+		pages := cloudwatchlogs.NewDescribeLogGroupsPaginator(conn, input)
+		token := aws.ToString(input.NextToken)
+		for pages.HasMorePages() {
+			// No further pages are requested once listing has been canceled.
+			if err := ctx.Err(); err != nil {
+				yield(logGroupPage{token: token, err: fmt.Errorf("listing CloudWatch Logs Log Groups: %w", err)})
+				return
+			}
 
-			result.DisplayName = aws.ToString(output.LogGroupName)
+			// Paginators don't advance on error, so a throttled page is requested again.
+			page, err := ratelimit.RetryThrottled(ctx, func(ctx context.Context) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+				if err := limiter.Wait(ctx); err != nil {
+					return nil, err
+				}
 
-			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
-			if result.Diagnostics.HasError() {
-				yield(result)
+				return pages.NextPage(ctx, observeThrottling(limiter))
+			})
+			if err != nil {
+				yield(logGroupPage{token: token, err: fmt.Errorf("listing CloudWatch Logs Log Groups: %w", err)})
 				return
 			}
 
-			if !yield(result) {
+			if !yield(logGroupPage{logGroups: page.LogGroups, token: token}) {
 				return
 			}
+			token = aws.ToString(page.NextToken)
+		}
+	}
+}
+
+// listTaggedLogGroupPages returns an iterator over pages of the log groups that have all of the specified tags,
+// along with their tags, keyed by ARN.
+// If namePrefix is not empty, only log groups whose names start with it are listed.
+// Matching ARNs are listed with the Resource Groups Tagging API, then described by name.
+// Errors are returned in the final page.
+func listTaggedLogGroupPages(ctx context.Context, conn cloudwatchlogs.DescribeLogGroupsAPIClient, taggingConn resourcegroupstaggingapi.GetResourcesAPIClient, scope ratelimit.Scope, limiter *ratelimit.Limiter, tags map[string]string, namePrefix string) iter.Seq[logGroupPage] {
+	return func(yield func(logGroupPage) bool) {
+		for tagged, err := range tftags.ListResourceTagsByTagFilter(ctx, taggingConn, scope, "logs:log-group", tags) {
+			if err != nil {
+				yield(logGroupPage{err: fmt.Errorf("listing tagged CloudWatch Logs Log Groups: %w", err)})
+				return
+			}
+
+			// DescribeLogGroups doesn't accept a name prefix along with log group identifiers.
+			logGroupNames := tfslices.Filter(tfslices.ApplyToAll(slices.Sorted(maps.Keys(tagged)), logGroupIdentifierToName), func(v string) bool {
+				return strings.HasPrefix(v, namePrefix)
+			})
+			for chunk := range slices.Chunk(logGroupNames, describeLogGroupsMaxLimit) {
+				if err := ctx.Err(); err != nil {
+					yield(logGroupPage{err: fmt.Errorf("listing tagged CloudWatch Logs Log Groups: %w", err)})
+					return
+				}
+
+				input := cloudwatchlogs.DescribeLogGroupsInput{
+					LogGroupIdentifiers: chunk,
+				}
+				for page := range listLogGroupPages(ctx, conn, &input, limiter) {
+					// Listing tagged log groups can't be resumed.
+					page.token = ""
+					page.tags = tagged
+					if !yield(page) || page.err != nil {
+						return
+					}
+				}
+			}
 		}
 	}
 }
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// Set to true to log log group listing metrics at Info, rather than Debug, level.
+	listPerformanceLogEnvVar = "TF_AWS_LIST_PERF_LOG"
+
+	// Progress is logged every this many pages of log groups.
+	logGroupListProgressPages = 10
+)
+
+// ListMetrics summarizes listing log groups in a single Region.
+// When the list resource's include_metrics argument is true, it is returned, encoded as JSON, as the detail of a
+// warning on a final result with no resource, so that listing performance can be checked without parsing logs.
+type ListMetrics struct {
+	Region             string  `json:"region"`
+	LogGroups          int     `json:"log_groups"`
+	Pages              int     `json:"pages"`
+	TagFetchDurationMS int64   `json:"tag_fetch_duration_ms"` // Summed across pages, whose tags may be fetched concurrently.
+	ElapsedMS          int64   `json:"elapsed_ms"`
+	LogGroupsPerSecond float64 `json:"log_groups_per_second"`
+	ThrottledCalls     int64   `json:"throttled_calls"`
+}
+
+// Summary of the warning with which ListMetrics are returned.
+const listMetricsSummary = "CloudWatch Logs Log Group Listing Metrics"
+
+// newLogGroupListMetricsResult returns the final result of listing log groups, which only has a warning with the metrics.
+func newLogGroupListMetricsResult(metrics ListMetrics) list.ListResult {
+	var result list.ListResult
+
+	detail, err := json.Marshal(metrics)
+	if err != nil {
+		result.Diagnostics.AddError(listMetricsSummary, err.Error())
+		return result
+	}
+	result.Diagnostics.AddWarning(listMetricsSummary, string(detail))
+
+	return result
+}
+
+// ListProgress is the progress of listing log groups in a single Region, which is reported every 10 pages.
+type ListProgress struct {
+	Region             string
+	Pages              int
+	LogGroups          int
+	LogGroupsPerSecond float64
+}
+
+type listProgressFuncKey struct{}
+
+// WithListProgressFunc returns a copy of ctx with which listing log groups calls f to report its progress,
+// for example so that a progress bar can be shown while a long listing runs.
+// f may be called concurrently when log groups are listed in more than one Region.
+func WithListProgressFunc(ctx context.Context, f func(ListProgress)) context.Context {
+	return context.WithValue(ctx, listProgressFuncKey{}, f)
+}
+
+// listProgressFunc returns the function to which progress is reported, or nil if there is none.
+func listProgressFunc(ctx context.Context) func(ListProgress) {
+	f, _ := ctx.Value(listProgressFuncKey{}).(func(ListProgress))
+	return f
+}
+
+// logGroupListMetrics summarizes listing log groups in a single Region.
+// It is logged, as structured fields, once listing finishes, so that listing performance can be measured,
+// and progress is logged periodically while listing, so that long listings can be followed.
+type logGroupListMetrics struct {
+	mutex            sync.Mutex
+	start            time.Time
+	logGroups        int
+	pages            int
+	tagFetchDuration time.Duration // Summed across pages, whose tags may be fetched concurrently.
+	throttles        int64
+	now              func() time.Time
+
+	// Progress is only reported to progress, for region, if it is set.
+	progress func(ListProgress)
+	region   string
+}
+
+func newLogGroupListMetrics() *logGroupListMetrics {
+	m := &logGroupListMetrics{
+		now: time.Now,
+	}
+	m.start = m.now()
+	return m
+}
+
+// page records a listed page of the specified number of log groups, and logs and reports progress every 10 pages.
+func (m *logGroupListMetrics) page(ctx context.Context, logGroups int) {
+	m.mutex.Lock()
+	m.pages++
+	m.logGroups += logGroups
+	progress := m.pages%logGroupListProgressPages == 0
+	m.mutex.Unlock()
+
+	if progress {
+		fields := m.fields()
+		// Throttled calls are only counted once listing finishes.
+		delete(fields, "throttled_calls")
+		logListPerformance(ctx, "Listing CloudWatch Logs Log Groups", fields)
+
+		if m.progress != nil {
+			v := m.summary(m.region)
+			m.progress(ListProgress{
+				Region:             v.Region,
+				Pages:              v.Pages,
+				LogGroups:          v.LogGroups,
+				LogGroupsPerSecond: v.LogGroupsPerSecond,
+			})
+		}
+	}
+}
+
+// fetchedTags records fetching the tags of a page of log groups, which started at the specified time.
+func (m *logGroupListMetrics) fetchedTags(start time.Time) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.tagFetchDuration += m.now().Sub(start)
+}
+
+// throttled records the specified number of throttled calls.
+func (m *logGroupListMetrics) throttled(n int64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.throttles += n
+}
+
+// summary returns the metrics of listing in the specified Region.
+func (m *logGroupListMetrics) summary(region string) ListMetrics {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	elapsed := m.now().Sub(m.start)
+	var rate float64
+	if elapsed > 0 {
+		rate = float64(m.logGroups) / elapsed.Seconds()
+	}
+
+	return ListMetrics{
+		Region:             region,
+		LogGroups:          m.logGroups,
+		Pages:              m.pages,
+		TagFetchDurationMS: m.tagFetchDuration.Milliseconds(),
+		ElapsedMS:          elapsed.Milliseconds(),
+		LogGroupsPerSecond: rate,
+		ThrottledCalls:     m.throttles,
+	}
+}
+
+// fields returns the metrics as log fields.
+func (m *logGroupListMetrics) fields() map[string]any {
+	v := m.summary("")
+
+	return map[string]any{
+		"log_groups":            v.LogGroups,
+		"pages":                 v.Pages,
+		"tag_fetch_duration_ms": v.TagFetchDurationMS,
+		"elapsed_ms":            v.ElapsedMS,
+		"log_groups_per_second": v.LogGroupsPerSecond,
+		"throttled_calls":       v.ThrottledCalls,
+	}
+}
+
+// log logs the metrics.
+func (m *logGroupListMetrics) log(ctx context.Context) {
+	logListPerformance(ctx, "Listed CloudWatch Logs Log Groups", m.fields())
+}
+
+// logListPerformance logs the specified message and fields at Debug level, unless Info level is enabled by TF_AWS_LIST_PERF_LOG.
+func logListPerformance(ctx context.Context, msg string, fields map[string]any) {
+	if v, _ := strconv.ParseBool(os.Getenv(listPerformanceLogEnvVar)); v {
+		tflog.Info(ctx, msg, fields)
+		return
+	}
+
+	tflog.Debug(ctx, msg, fields)
+}
@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLogGroupListMetrics(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := newLogGroupListMetrics()
+	m.start = now
+	m.now = func() time.Time {
+		return now
+	}
+
+	m.page(t.Context(), 50)
+	m.page(t.Context(), 30)
+
+	// Tags are fetched for both pages concurrently.
+	start := now
+	now = now.Add(1 * time.Second)
+	m.fetchedTags(start)
+	now = now.Add(1 * time.Second)
+	m.fetchedTags(start)
+
+	m.throttled(3)
+
+	now = now.Add(2 * time.Second)
+
+	want := map[string]any{
+		"log_groups":            80,
+		"pages":                 2,
+		"tag_fetch_duration_ms": int64(3000),
+		"elapsed_ms":            int64(4000),
+		"log_groups_per_second": 20.0,
+		"throttled_calls":       int64(3),
+	}
+	if diff := cmp.Diff(m.fields(), want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	wantSummary := ListMetrics{
+		Region:             "us-west-2", //lintignore:AWSAT003
+		LogGroups:          80,
+		Pages:              2,
+		TagFetchDurationMS: 3000,
+		ElapsedMS:          4000,
+		LogGroupsPerSecond: 20.0,
+		ThrottledCalls:     3,
+	}
+	if diff := cmp.Diff(m.summary("us-west-2"), wantSummary); diff != "" { //lintignore:AWSAT003
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestNewLogGroupListMetricsResult(t *testing.T) {
+	t.Parallel()
+
+	metrics := ListMetrics{
+		Region:             "us-west-2", //lintignore:AWSAT003
+		LogGroups:          80,
+		Pages:              2,
+		TagFetchDurationMS: 3000,
+		ElapsedMS:          4000,
+		LogGroupsPerSecond: 20.0,
+		ThrottledCalls:     3,
+	}
+	result := newLogGroupListMetricsResult(metrics)
+
+	if result.DisplayName != "" || result.Identity != nil || result.Resource != nil {
+		t.Errorf("expected a result with only diagnostics, got %#v", result)
+	}
+	if got, want := len(result.Diagnostics), 1; got != want {
+		t.Fatalf("expected %d diagnostics, got %d", want, got)
+	}
+	warning := result.Diagnostics[0]
+	if got, want := warning.Severity(), diag.SeverityWarning; got != want {
+		t.Errorf("expected severity %s, got %s", want, got)
+	}
+
+	// The metrics can be read back from the warning's detail.
+	var got ListMetrics
+	if err := json.Unmarshal([]byte(warning.Detail()), &got); err != nil {
+		t.Fatalf("decoding metrics: %s", err)
+	}
+	if diff := cmp.Diff(got, metrics); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestLogGroupListMetricsPage_progress(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := newLogGroupListMetrics()
+	m.start = now
+	m.now = func() time.Time {
+		return now
+	}
+
+	var got []ListProgress
+	m.progress = func(v ListProgress) {
+		got = append(got, v)
+	}
+	m.region = "us-west-2" //lintignore:AWSAT003
+
+	for range 25 {
+		now = now.Add(1 * time.Second)
+		m.page(t.Context(), 2)
+	}
+
+	// Progress is reported after 10 and 20 pages.
+	want := []ListProgress{
+		{
+			Region:             "us-west-2", //lintignore:AWSAT003
+			Pages:              10,
+			LogGroups:          20,
+			LogGroupsPerSecond: 2.0,
+		},
+		{
+			Region:             "us-west-2", //lintignore:AWSAT003
+			Pages:              20,
+			LogGroups:          40,
+			LogGroupsPerSecond: 2.0,
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestWithListProgressFunc(t *testing.T) {
+	t.Parallel()
+
+	if f := listProgressFunc(t.Context()); f != nil {
+		t.Error("expected no progress function")
+	}
+
+	var called bool
+	ctx := WithListProgressFunc(t.Context(), func(ListProgress) {
+		called = true
+	})
+	f := listProgressFunc(ctx)
+	if f == nil {
+		t.Fatal("expected a progress function")
+	}
+	f(ListProgress{})
+	if !called {
+		t.Error("expected the progress function to be called")
+	}
+}
+
+func TestLogGroupListMetricsLog(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	testCases := map[string]struct {
+		env           string
+		expectedLevel string
+	}{
+		"unset": {
+			expectedLevel: "debug",
+		},
+		"true": {
+			env:           "true",
+			expectedLevel: "info",
+		},
+		"false": {
+			env:           "false",
+			expectedLevel: "debug",
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // uses t.Setenv
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(listPerformanceLogEnvVar, testCase.env)
+
+			var buf bytes.Buffer
+			ctx := tflogtest.RootLogger(t.Context(), &buf)
+
+			newLogGroupListMetrics().log(ctx)
+
+			lines, err := tflogtest.MultilineJSONDecode(&buf)
+			if err != nil {
+				t.Fatalf("decoding log lines: %s", err)
+			}
+			if got, want := len(lines), 1; got != want {
+				t.Fatalf("expected %d log lines, got %d", want, got)
+			}
+			if got, want := lines[0]["@level"], testCase.expectedLevel; got != want {
+				t.Errorf("expected level %q, got %q", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,684 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"maps"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	taggingtypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+func TestListLogGroupPagesWithTags_pipelined(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockDescribeLogGroupsClient{
+		pages:  [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+		called: make(chan int, 3),
+	}
+
+	// Fetching a page's tags blocks until the following page has been requested,
+	// which only happens if listing and tag fetching run concurrently.
+	var fetched int
+	fetchTags := func(_ context.Context, arns []string) (map[string]map[string]string, map[string]error, error) {
+		fetched++
+		if fetched < len(conn.pages) {
+			select {
+			case <-conn.requested(fetched + 1):
+			case <-time.After(5 * time.Second):
+				return nil, nil, errors.New("next page not requested while fetching tags")
+			}
+		}
+
+		tags := make(map[string]map[string]string, len(arns))
+		for _, arn := range arns {
+			tags[arn] = map[string]string{"page": strconv.Itoa(fetched)}
+		}
+		return tags, nil, nil
+	}
+
+	var names []string
+	for page := range listLogGroupPagesWithTags(t.Context(), func(ctx context.Context) iter.Seq[logGroupPage] {
+		return listLogGroupPages(ctx, conn, &cloudwatchlogs.DescribeLogGroupsInput{}, rateLimiters.For(ratelimit.Scope{AccountID: "111111111111", Region: "us-west-2"})) //lintignore:AWSAT003
+	}, 0, fetchTags, 1) {
+		if page.err != nil {
+			t.Fatalf("unexpected error: %s", page.err)
+		}
+		for _, v := range page.logGroups {
+			names = append(names, aws.ToString(v.LogGroupName))
+			if page.tags[aws.ToString(v.Arn)] == nil {
+				t.Errorf("expected tags for %s", aws.ToString(v.LogGroupName))
+			}
+		}
+	}
+
+	if diff := cmp.Diff(names, []string{"a", "b", "c", "d", "e"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestListLogGroupPages_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	conn := &mockDescribeLogGroupsClient{
+		pages:  [][]string{{"a", "b"}, {"c", "d"}},
+		called: make(chan int, 2),
+	}
+
+	var pages []logGroupPage
+	for page := range listLogGroupPages(ctx, conn, &cloudwatchlogs.DescribeLogGroupsInput{}, rateLimiters.For(ratelimit.Scope{AccountID: "111111111111", Region: "us-west-2"})) { //lintignore:AWSAT003
+		pages = append(pages, page)
+		// Listing is canceled once the first page is listed.
+		cancel()
+	}
+
+	if got, want := len(pages), 2; got != want {
+		t.Fatalf("expected %d pages, got %d", want, got)
+	}
+	if err := pages[1].err; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %s, got %v", context.Canceled, err)
+	}
+	if got, want := len(conn.called), 1; got != want {
+		t.Errorf("expected %d DescribeLogGroups calls, got %d", want, got)
+	}
+}
+
+func TestListLogGroupPages_resumable(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	conn := &mockDescribeLogGroupsClient{
+		pages:  [][]string{{"a"}, {"b"}, {"c"}},
+		called: make(chan int, 3),
+	}
+	input := cloudwatchlogs.DescribeLogGroupsInput{
+		NextToken: aws.String("1"),
+	}
+
+	var pages []logGroupPage
+	for page := range listLogGroupPages(ctx, conn, &input, rateLimiters.For(ratelimit.Scope{AccountID: "111111111111", Region: "us-west-2"})) { //lintignore:AWSAT003
+		pages = append(pages, page)
+		// Listing is canceled once the first page is listed.
+		cancel()
+	}
+
+	if got, want := len(pages), 2; got != want {
+		t.Fatalf("expected %d pages, got %d", want, got)
+	}
+	if got, want := aws.ToString(pages[0].logGroups[0].LogGroupName), "b"; got != want {
+		t.Errorf("expected listing to resume at %q, got %q", want, got)
+	}
+	if got, want := pages[0].token, "1"; got != want {
+		t.Errorf("expected first page token %q, got %q", want, got)
+	}
+	// Listing can be resumed from the page that wasn't listed.
+	if got, want := pages[1].token, "2"; got != want {
+		t.Errorf("expected failed page token %q, got %q", want, got)
+	}
+}
+
+func TestDedupeLogGroupPages(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockDescribeLogGroupsClient{
+		// "b" is returned on both pages, as if a log group was created before it while listing.
+		pages:  [][]string{{"a", "b"}, {"b", "c"}, {"d"}},
+		called: make(chan int, 3),
+	}
+
+	var got [][]string
+	for page := range dedupeLogGroupPages(listLogGroupPages(t.Context(), conn, &cloudwatchlogs.DescribeLogGroupsInput{}, rateLimiters.For(ratelimit.Scope{AccountID: "111111111111", Region: "us-west-2"})), logGroupDedupeMaxSize) { //lintignore:AWSAT003
+		if page.err != nil {
+			t.Fatalf("unexpected error: %s", page.err)
+		}
+		got = append(got, tfslices.ApplyToAll(page.logGroups, func(v awstypes.LogGroup) string {
+			return aws.ToString(v.LogGroupName)
+		}))
+	}
+
+	want := [][]string{{"a", "b"}, {"c"}, {"d"}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestDedupeLogGroupPages_maxSize(t *testing.T) {
+	t.Parallel()
+
+	pages := func(yield func(logGroupPage) bool) {
+		for _, names := range [][]string{{"a", "b"}, {"c"}, {"a", "c"}, {"b", "d", "d", "a"}} {
+			var page logGroupPage
+			for _, name := range names {
+				page.logGroups = append(page.logGroups, awstypes.LogGroup{LogGroupName: aws.String(name)})
+			}
+			if !yield(page) {
+				return
+			}
+		}
+	}
+
+	var got []string
+	for page := range dedupeLogGroupPages(pages, 2) {
+		for _, v := range page.logGroups {
+			got = append(got, aws.ToString(v.LogGroupName))
+		}
+	}
+
+	// "a" has been forgotten by the time it's returned again, but "c" hasn't.
+	// Later, "b" has been forgotten too, and "a" is forgotten again once "b" and "d" have been returned.
+	if diff := cmp.Diff(got, []string{"a", "b", "c", "a", "b", "d", "a"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFilterUnprocessedLogGroupPages(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockLogGroupFiltersClient{
+		metricFilters:       []string{"a", "c"},
+		subscriptionFilters: []string{"b", "c"},
+		errs: map[string]error{
+			"d": &awstypes.ResourceNotFoundException{},
+		},
+	}
+	pages := func(yield func(logGroupPage) bool) {
+		for _, names := range [][]string{{"a", "b"}, {"c", "d", "e"}} {
+			page := logGroupPage{
+				logGroups: tfslices.ApplyToAll(names, func(name string) awstypes.LogGroup {
+					return awstypes.LogGroup{LogGroupName: aws.String(name)}
+				}),
+			}
+			if !yield(page) {
+				return
+			}
+		}
+	}
+
+	testCases := map[string]struct {
+		noMetricFilters       bool
+		noSubscriptionFilters bool
+		want                  [][]string
+		wantMetricCalls       []string
+		wantSubscriptionCalls []string
+	}{
+		"no_metric_filters": {
+			noMetricFilters: true,
+			want:            [][]string{{"b"}, {"e"}},
+			wantMetricCalls: []string{"a", "b", "c", "d", "e"},
+		},
+		"no_subscription_filters": {
+			noSubscriptionFilters: true,
+			want:                  [][]string{{"a"}, {"e"}},
+			wantSubscriptionCalls: []string{"a", "b", "c", "d", "e"},
+		},
+		"both": {
+			noMetricFilters:       true,
+			noSubscriptionFilters: true,
+			want:                  [][]string{{}, {"e"}},
+			wantMetricCalls:       []string{"a", "b", "c", "d", "e"},
+			// Log groups with metric filters aren't checked for subscription filters.
+			wantSubscriptionCalls: []string{"b", "e"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := conn.clone()
+
+			var got [][]string
+			for page := range filterUnprocessedLogGroupPages(t.Context(), conn, rateLimiters.For(ratelimit.Scope{AccountID: "888888888888", Region: "us-west-2"}), pages, testCase.noMetricFilters, testCase.noSubscriptionFilters) { //lintignore:AWSAT003
+				if page.err != nil {
+					t.Fatalf("unexpected error: %s", page.err)
+				}
+				got = append(got, tfslices.ApplyToAll(page.logGroups, func(v awstypes.LogGroup) string {
+					return aws.ToString(v.LogGroupName)
+				}))
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(conn.metricCalls, testCase.wantMetricCalls); diff != "" {
+				t.Errorf("unexpected DescribeMetricFilters calls (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(conn.subscriptionCalls, testCase.wantSubscriptionCalls); diff != "" {
+				t.Errorf("unexpected DescribeSubscriptionFilters calls (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterUnprocessedLogGroupPages_error(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockLogGroupFiltersClient{
+		errs: map[string]error{
+			"b": &awstypes.ServiceUnavailableException{},
+		},
+	}
+	pages := func(yield func(logGroupPage) bool) {
+		for _, names := range [][]string{{"a", "b"}, {"c"}} {
+			page := logGroupPage{
+				logGroups: tfslices.ApplyToAll(names, func(name string) awstypes.LogGroup {
+					return awstypes.LogGroup{LogGroupName: aws.String(name)}
+				}),
+			}
+			if !yield(page) {
+				return
+			}
+		}
+	}
+
+	var got []logGroupPage
+	for page := range filterUnprocessedLogGroupPages(t.Context(), conn, rateLimiters.For(ratelimit.Scope{AccountID: "888888888888", Region: "us-west-2"}), pages, true, false) { //lintignore:AWSAT003
+		got = append(got, page)
+	}
+
+	// Listing stops at the page with the log group that couldn't be checked.
+	if len(got) != 1 {
+		t.Fatalf("expected 1 page, got %d", len(got))
+	}
+	if !errs.IsA[*awstypes.ServiceUnavailableException](got[0].err) {
+		t.Errorf("expected ServiceUnavailableException, got %v", got[0].err)
+	}
+	if len(got[0].logGroups) > 0 {
+		t.Errorf("expected no log groups, got %d", len(got[0].logGroups))
+	}
+}
+
+func TestListLogGroupPagesWithTags_maxConcurrency(t *testing.T) {
+	t.Parallel()
+
+	for _, maxConcurrency := range []int{1, 3} {
+		t.Run(strconv.Itoa(maxConcurrency), func(t *testing.T) {
+			t.Parallel()
+
+			conn := &mockDescribeLogGroupsClient{
+				pages:  [][]string{{"a"}, {"b"}, {"c"}, {"d"}, {"e"}, {"f"}, {"g"}, {"h"}},
+				called: make(chan int, 8),
+			}
+
+			var running, maxRunning atomic.Int32
+			fetchTags := func(_ context.Context, arns []string) (map[string]map[string]string, map[string]error, error) {
+				r := running.Add(1)
+				for {
+					m := maxRunning.Load()
+					if r <= m || maxRunning.CompareAndSwap(m, r) {
+						break
+					}
+				}
+				defer running.Add(-1)
+
+				time.Sleep(20 * time.Millisecond)
+
+				return nil, nil, nil
+			}
+
+			var count int
+			for page := range listLogGroupPagesWithTags(t.Context(), func(ctx context.Context) iter.Seq[logGroupPage] {
+				return listLogGroupPages(ctx, conn, &cloudwatchlogs.DescribeLogGroupsInput{}, rateLimiters.For(ratelimit.Scope{AccountID: "33333333333" + strconv.Itoa(maxConcurrency), Region: "us-west-2"})) //lintignore:AWSAT003
+			}, 0, fetchTags, maxConcurrency) {
+				if page.err != nil {
+					t.Fatalf("unexpected error: %s", page.err)
+				}
+				count += len(page.logGroups)
+			}
+
+			if got, want := count, 8; got != want {
+				t.Errorf("expected %d log groups, got %d", want, got)
+			}
+			if got := int(maxRunning.Load()); got > maxConcurrency {
+				t.Errorf("expected at most %d concurrent tag fetches, got %d", maxConcurrency, got)
+			}
+		})
+	}
+}
+
+func TestListTaggedLogGroupPages(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockDescribeLogGroupsClient{
+		called: make(chan int, 2),
+	}
+	taggingConn := &mockGetResourcesClient{
+		tags: map[string]map[string]string{
+			"arn:aws:logs:us-west-2:123456789012:log-group:b": {"team": "payments"}, //lintignore:AWSAT003,AWSAT005
+			"arn:aws:logs:us-west-2:123456789012:log-group:a": {"team": "payments"}, //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	var names []string
+	for page := range listTaggedLogGroupPages(t.Context(), conn, taggingConn, ratelimit.Scope{AccountID: "444444444444", Region: "us-west-2"}, rateLimiters.For(ratelimit.Scope{AccountID: "444444444444", Region: "us-west-2"}), map[string]string{"team": "payments"}, "") { //lintignore:AWSAT003
+		if page.err != nil {
+			t.Fatalf("unexpected error: %s", page.err)
+		}
+		for _, v := range page.logGroups {
+			names = append(names, aws.ToString(v.LogGroupName))
+			if got, want := page.tags[trimLogGroupARNWildcardSuffix(aws.ToString(v.Arn))]["team"], "payments"; got != want {
+				t.Errorf("expected tag %q for %s, got %q", want, aws.ToString(v.LogGroupName), got)
+			}
+		}
+	}
+
+	if diff := cmp.Diff(names, []string{"a", "b"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	var filters []taggingtypes.TagFilter
+	for _, input := range taggingConn.inputs {
+		filters = append(filters, input.TagFilters...)
+	}
+	if got, want := len(filters), 1; got != want {
+		t.Errorf("expected %d tag filters, got %d", want, got)
+	}
+}
+
+func TestListTaggedLogGroupPages_namePrefix(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockDescribeLogGroupsClient{
+		called: make(chan int, 1),
+	}
+	taggingConn := &mockGetResourcesClient{
+		tags: map[string]map[string]string{
+			"arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/a": {"team": "payments"}, //lintignore:AWSAT003,AWSAT005
+			"arn:aws:logs:us-west-2:123456789012:log-group:/aws/ecs/b":    {"team": "payments"}, //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	var names []string
+	for page := range listTaggedLogGroupPages(t.Context(), conn, taggingConn, ratelimit.Scope{AccountID: "555555555555", Region: "us-west-2"}, rateLimiters.For(ratelimit.Scope{AccountID: "555555555555", Region: "us-west-2"}), map[string]string{"team": "payments"}, "/aws/lambda/") { //lintignore:AWSAT003
+		if page.err != nil {
+			t.Fatalf("unexpected error: %s", page.err)
+		}
+		for _, v := range page.logGroups {
+			names = append(names, aws.ToString(v.LogGroupName))
+		}
+	}
+
+	if diff := cmp.Diff(names, []string{"/aws/lambda/a"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFetchMissingLogGroupTags(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockListTagsForResourceClient{
+		tags: map[string]map[string]string{
+			"arn:aws:logs:us-west-2:123456789012:log-group:b": {"team": "payments"}, //lintignore:AWSAT003,AWSAT005
+		},
+	}
+	arns := []string{
+		"arn:aws:logs:us-west-2:123456789012:log-group:a", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:logs:us-west-2:123456789012:log-group:b", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:logs:us-west-2:123456789012:log-group:c", //lintignore:AWSAT003,AWSAT005
+	}
+	tags := map[string]map[string]string{
+		"arn:aws:logs:us-west-2:123456789012:log-group:a": {"team": "search"}, //lintignore:AWSAT003,AWSAT005
+		"arn:aws:logs:us-west-2:123456789012:log-group:b": {},                 //lintignore:AWSAT003,AWSAT005
+	}
+
+	got, tagErrs, err := fetchMissingLogGroupTags(t.Context(), conn, rateLimiters.For(ratelimit.Scope{AccountID: "666666666666", Region: "us-west-2"}), arns, tags) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(tagErrs) > 0 {
+		t.Errorf("unexpected tag errors: %v", tagErrs)
+	}
+
+	want := map[string]map[string]string{
+		"arn:aws:logs:us-west-2:123456789012:log-group:a": {"team": "search"},   //lintignore:AWSAT003,AWSAT005
+		"arn:aws:logs:us-west-2:123456789012:log-group:b": {"team": "payments"}, //lintignore:AWSAT003,AWSAT005
+		"arn:aws:logs:us-west-2:123456789012:log-group:c": nil,                  //lintignore:AWSAT003,AWSAT005
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Only log groups without tags from the Resource Groups Tagging API are read individually.
+	if diff := cmp.Diff(conn.arns, arns[1:]); diff != "" {
+		t.Errorf("unexpected ListTagsForResource calls (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFetchMissingLogGroupTags_errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err           error
+		expectFatal   bool
+		expectTagErrs []string
+	}{
+		"not found": {
+			err: &awstypes.ResourceNotFoundException{},
+			expectTagErrs: []string{
+				"arn:aws:logs:us-west-2:123456789012:log-group:a", //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		"service unavailable": {
+			err: &awstypes.ServiceUnavailableException{},
+			expectTagErrs: []string{
+				"arn:aws:logs:us-west-2:123456789012:log-group:a", //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		"access denied": {
+			err:         &smithy.GenericAPIError{Code: "AccessDeniedException"},
+			expectFatal: true,
+		},
+		"expired token": {
+			err:         &smithy.GenericAPIError{Code: "ExpiredTokenException"},
+			expectFatal: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := &mockListTagsForResourceClient{
+				tags: map[string]map[string]string{
+					"arn:aws:logs:us-west-2:123456789012:log-group:b": {"team": "payments"}, //lintignore:AWSAT003,AWSAT005
+				},
+				errs: map[string]error{
+					"arn:aws:logs:us-west-2:123456789012:log-group:a": testCase.err, //lintignore:AWSAT003,AWSAT005
+				},
+			}
+			arns := []string{
+				"arn:aws:logs:us-west-2:123456789012:log-group:a", //lintignore:AWSAT003,AWSAT005
+				"arn:aws:logs:us-west-2:123456789012:log-group:b", //lintignore:AWSAT003,AWSAT005
+			}
+
+			got, tagErrs, err := fetchMissingLogGroupTags(t.Context(), conn, rateLimiters.For(ratelimit.Scope{AccountID: "777777777777", Region: "us-west-2"}), arns, make(map[string]map[string]string)) //lintignore:AWSAT003
+			if testCase.expectFatal {
+				if !errors.Is(err, testCase.err) {
+					t.Fatalf("expected %s, got %v", testCase.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(slices.Sorted(maps.Keys(tagErrs)), testCase.expectTagErrs); diff != "" {
+				t.Errorf("unexpected tag errors (+wanted, -got): %s", diff)
+			}
+
+			// The tags of the other log groups are still read.
+			if diff := cmp.Diff(got["arn:aws:logs:us-west-2:123456789012:log-group:b"], map[string]string{"team": "payments"}); diff != "" { //lintignore:AWSAT003,AWSAT005
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+// mockDescribeLogGroupsClient returns the named log groups, one page at a time.
+// If log groups are identified, they are returned in a single page instead.
+// The number of each page is sent on called as it is requested.
+type mockDescribeLogGroupsClient struct {
+	pages  [][]string
+	called chan int
+}
+
+func (c *mockDescribeLogGroupsClient) DescribeLogGroups(_ context.Context, input *cloudwatchlogs.DescribeLogGroupsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	i, _ := strconv.Atoi(aws.ToString(input.NextToken))
+	c.called <- i + 1
+
+	names := input.LogGroupIdentifiers
+	if names == nil {
+		names = c.pages[i]
+	}
+
+	var output cloudwatchlogs.DescribeLogGroupsOutput
+	for _, name := range names {
+		output.LogGroups = append(output.LogGroups, awstypes.LogGroup{
+			Arn:          aws.String("arn:aws:logs:us-west-2:123456789012:log-group:" + name), //lintignore:AWSAT003,AWSAT005
+			LogGroupName: aws.String(name),
+		})
+	}
+	if input.LogGroupIdentifiers == nil && i+1 < len(c.pages) {
+		output.NextToken = aws.String(strconv.Itoa(i + 1))
+	}
+
+	return &output, nil
+}
+
+// requested returns a channel that is closed once the specified page has been requested.
+func (c *mockDescribeLogGroupsClient) requested(page int) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		for i := range c.called {
+			if i >= page {
+				close(done)
+				return
+			}
+		}
+	}()
+	return done
+}
+
+// mockGetResourcesClient returns the log groups that have all of the filtered tags, in a single page.
+type mockGetResourcesClient struct {
+	tags   map[string]map[string]string
+	inputs []*resourcegroupstaggingapi.GetResourcesInput
+}
+
+func (c *mockGetResourcesClient) GetResources(_ context.Context, input *resourcegroupstaggingapi.GetResourcesInput, _ ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	c.inputs = append(c.inputs, input)
+
+	var output resourcegroupstaggingapi.GetResourcesOutput
+	for arn, tags := range c.tags {
+		if slices.ContainsFunc(input.TagFilters, func(filter taggingtypes.TagFilter) bool {
+			return !slices.Contains(filter.Values, tags[aws.ToString(filter.Key)])
+		}) {
+			continue
+		}
+
+		mapping := taggingtypes.ResourceTagMapping{
+			ResourceARN: aws.String(arn),
+		}
+		for k, v := range tags {
+			mapping.Tags = append(mapping.Tags, taggingtypes.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+		output.ResourceTagMappingList = append(output.ResourceTagMappingList, mapping)
+	}
+
+	return &output, nil
+}
+
+// mockListTagsForResourceClient returns the tags of the requested log group, or its error.
+type mockListTagsForResourceClient struct {
+	tags map[string]map[string]string
+	errs map[string]error
+	arns []string
+}
+
+func (c *mockListTagsForResourceClient) ListTagsForResource(_ context.Context, input *cloudwatchlogs.ListTagsForResourceInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.ListTagsForResourceOutput, error) {
+	c.arns = append(c.arns, aws.ToString(input.ResourceArn))
+
+	if err, ok := c.errs[aws.ToString(input.ResourceArn)]; ok {
+		return nil, err
+	}
+
+	return &cloudwatchlogs.ListTagsForResourceOutput{
+		Tags: c.tags[aws.ToString(input.ResourceArn)],
+	}, nil
+}
+
+// mockLogGroupFiltersClient returns a single metric or subscription filter for the named log groups that have them,
+// or the log group's error.
+type mockLogGroupFiltersClient struct {
+	metricFilters       []string
+	subscriptionFilters []string
+	errs                map[string]error
+
+	metricCalls       []string
+	subscriptionCalls []string
+}
+
+func (c *mockLogGroupFiltersClient) clone() *mockLogGroupFiltersClient {
+	return &mockLogGroupFiltersClient{
+		metricFilters:       c.metricFilters,
+		subscriptionFilters: c.subscriptionFilters,
+		errs:                c.errs,
+	}
+}
+
+func (c *mockLogGroupFiltersClient) DescribeMetricFilters(_ context.Context, input *cloudwatchlogs.DescribeMetricFiltersInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeMetricFiltersOutput, error) {
+	name := aws.ToString(input.LogGroupName)
+	c.metricCalls = append(c.metricCalls, name)
+
+	if err, ok := c.errs[name]; ok {
+		return nil, err
+	}
+
+	var output cloudwatchlogs.DescribeMetricFiltersOutput
+	if slices.Contains(c.metricFilters, name) {
+		output.MetricFilters = []awstypes.MetricFilter{{LogGroupName: aws.String(name)}}
+	}
+
+	return &output, nil
+}
+
+func (c *mockLogGroupFiltersClient) DescribeSubscriptionFilters(_ context.Context, input *cloudwatchlogs.DescribeSubscriptionFiltersInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeSubscriptionFiltersOutput, error) {
+	name := aws.ToString(input.LogGroupName)
+	c.subscriptionCalls = append(c.subscriptionCalls, name)
+
+	if err, ok := c.errs[name]; ok {
+		return nil, err
+	}
+
+	var output cloudwatchlogs.DescribeSubscriptionFiltersOutput
+	if slices.Contains(c.subscriptionFilters, name) {
+		output.SubscriptionFilters = []awstypes.SubscriptionFilter{{LogGroupName: aws.String(name)}}
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLogGroupListResourceModelPredicate(t *testing.T) {
+	t.Parallel()
+
+	logGroups := []awstypes.LogGroup{
+		{
+			LogGroupName:    aws.String("old"),
+			CreationTime:    aws.Int64(1577836800000), // 2020-01-01T00:00:00Z
+			RetentionInDays: aws.Int32(30),
+			StoredBytes:     aws.Int64(1024),
+			KmsKeyId:        aws.String("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"), //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			LogGroupName: aws.String("new"),
+			CreationTime: aws.Int64(1735689600000), // 2025-01-01T00:00:00Z
+			StoredBytes:  aws.Int64(0),
+		},
+		{
+			LogGroupName: aws.String("unknown"),
+		},
+		{
+			LogGroupName: aws.String("/aws/lambda/fn"),
+		},
+	}
+
+	testCases := map[string]struct {
+		query    logGroupListResourceModel
+		expected []string
+	}{
+		"empty": {
+			expected: []string{"old", "new", "unknown", "/aws/lambda/fn"},
+		},
+		"created_after": {
+			query: logGroupListResourceModel{
+				CreatedAfter: timetypes.NewRFC3339ValueMust("2022-01-01T00:00:00Z"),
+			},
+			expected: []string{"new"},
+		},
+		"created_before": {
+			query: logGroupListResourceModel{
+				CreatedBefore: timetypes.NewRFC3339ValueMust("2022-01-01T00:00:00Z"),
+			},
+			expected: []string{"old"},
+		},
+		"no_retention": {
+			query: logGroupListResourceModel{
+				NoRetention: types.BoolValue(true),
+			},
+			expected: []string{"new", "unknown", "/aws/lambda/fn"},
+		},
+		"exclude_aws_managed": {
+			query: logGroupListResourceModel{
+				ExcludeAWSManaged: types.BoolValue(true),
+			},
+			expected: []string{"old", "new", "unknown"},
+		},
+		"exclude_aws_managed false": {
+			query: logGroupListResourceModel{
+				ExcludeAWSManaged: types.BoolValue(false),
+			},
+			expected: []string{"old", "new", "unknown", "/aws/lambda/fn"},
+		},
+		"retention_in_days": {
+			query: logGroupListResourceModel{
+				RetentionInDays: types.Int64Value(30),
+			},
+			expected: []string{"old"},
+		},
+		"stored_bytes_gt": {
+			query: logGroupListResourceModel{
+				StoredBytesGT: types.Int64Value(0),
+			},
+			expected: []string{"old"},
+		},
+		"unencrypted_only": {
+			query: logGroupListResourceModel{
+				UnencryptedOnly: types.BoolValue(true),
+			},
+			expected: []string{"new", "unknown", "/aws/lambda/fn"},
+		},
+		"created_after and created_before": {
+			query: logGroupListResourceModel{
+				CreatedAfter:  timetypes.NewRFC3339ValueMust("2021-01-01T00:00:00Z"),
+				CreatedBefore: timetypes.NewRFC3339ValueMust("2022-01-01T00:00:00Z"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			predicate, diags := testCase.query.predicate()
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			var got []string
+			for _, v := range logGroups {
+				if predicate(&v) {
+					got = append(got, aws.ToString(v.LogGroupName))
+				}
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLogGroupListResourceModelPageSize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		query    logGroupListResourceModel
+		limit    int64
+		expected int32
+	}{
+		"empty": {
+			expected: describeLogGroupsMaxLimit,
+		},
+		"page_size": {
+			query: logGroupListResourceModel{
+				PageSize: types.Int64Value(1),
+			},
+			expected: 1,
+		},
+		"limit": {
+			limit:    10,
+			expected: 10,
+		},
+		"limit above maximum": {
+			limit:    100,
+			expected: describeLogGroupsMaxLimit,
+		},
+		"page_size below limit": {
+			query: logGroupListResourceModel{
+				PageSize: types.Int64Value(5),
+			},
+			limit:    10,
+			expected: 5,
+		},
+		"page_size above limit": {
+			query: logGroupListResourceModel{
+				PageSize: types.Int64Value(20),
+			},
+			limit:    10,
+			expected: 10,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := testCase.query.pageSize(testCase.limit), testCase.expected; got != expected {
+				t.Errorf("expected page size %d, got %d", expected, got)
+			}
+		})
+	}
+}
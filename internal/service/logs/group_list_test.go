@@ -4,8 +4,11 @@
 package logs_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -143,3 +146,156 @@ func TestAccLogsLogGroup_List_regionOverride(t *testing.T) {
 		},
 	})
 }
+
+func TestLogsLogGroup_List_replay(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// Log groups are listed two to a page, and their tags are fetched a page at a time.
+	acctest.ListReplayTest(ctx, t, acctest.ListReplayTestCase{
+		CassetteName:     "testdata/LogGroup/list_replay",
+		ListResourceType: "aws_cloudwatch_log_group",
+		Config: map[string]tftypes.Value{
+			"page_size": tftypes.NewValue(tftypes.Number, 2),
+		},
+		IncludeResource: true,
+		ExpectedResults: []acctest.ListResult{
+			{
+				DisplayName: "/test/one",
+				Tags: map[string]string{
+					"Name": "one",
+				},
+			},
+			{
+				DisplayName: "/test/two",
+				Tags: map[string]string{
+					"Environment": "test",
+					"Name":        "two",
+				},
+			},
+			{
+				DisplayName: "/test/three",
+				Tags: map[string]string{
+					"Name": "three",
+				},
+			},
+		},
+	})
+}
+
+func TestLogsLogGroup_List_limit(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// Listing stops on the page on which the limit is reached.
+	acctest.ListTest(ctx, t, acctest.ListTestCase{
+		ListResourceType: "aws_cloudwatch_log_group",
+		Config: map[string]tftypes.Value{
+			"page_size": tftypes.NewValue(tftypes.Number, 2),
+		},
+		Limit: 3,
+		API: acctest.ListFakeAPI{
+			Responses: map[string][]acctest.ListFakeResponse{
+				"DescribeLogGroups": {
+					{Body: testDescribeLogGroupsPage(t, "1", "a", "b")},
+					{Body: testDescribeLogGroupsPage(t, "2", "c", "d")},
+					{Body: testDescribeLogGroupsPage(t, "", "e")},
+				},
+			},
+		},
+		Checks: []acctest.ListCheck{
+			acctest.ExpectListDisplayNames("a", "b", "c"),
+			acctest.ExpectListCallCount("DescribeLogGroups", 2),
+		},
+	})
+}
+
+func TestLogsLogGroup_List_rateLimit(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var responses []acctest.ListFakeResponse
+	for i := range 8 {
+		responses = append(responses, acctest.ListFakeResponse{
+			Body: testDescribeLogGroupsPage(t, fmt.Sprint(i+1), fmt.Sprintf("group-%d", i)),
+		})
+	}
+	responses = append(responses, acctest.ListFakeResponse{
+		Body: testDescribeLogGroupsPage(t, ""),
+	})
+
+	acctest.ListTest(ctx, t, acctest.ListTestCase{
+		ListResourceType: "aws_cloudwatch_log_group",
+		ProviderConfig: map[string]tftypes.Value{
+			"list_rate_limits": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Number}, map[string]tftypes.Value{
+				"CloudWatchLogs": tftypes.NewValue(tftypes.Number, 10),
+			}),
+		},
+		Config: map[string]tftypes.Value{
+			"page_size": tftypes.NewValue(tftypes.Number, 1),
+		},
+		API: acctest.ListFakeAPI{
+			Responses: map[string][]acctest.ListFakeResponse{
+				"DescribeLogGroups": responses,
+			},
+		},
+		Checks: []acctest.ListCheck{
+			acctest.ExpectListResultCount(8),
+			acctest.ExpectListCallRate("DescribeLogGroups", 10, 5),
+		},
+	})
+}
+
+func TestLogsLogGroup_List_storedBytes(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// Stored bytes are shown in the display names of log groups filtered on them.
+	acctest.ListTest(ctx, t, acctest.ListTestCase{
+		ListResourceType: "aws_cloudwatch_log_group",
+		Config: map[string]tftypes.Value{
+			"stored_bytes_gt": tftypes.NewValue(tftypes.Number, 1024),
+		},
+		API: acctest.ListFakeAPI{
+			Responses: map[string][]acctest.ListFakeResponse{
+				"DescribeLogGroups": {
+					{Body: `{"logGroups":[{"logGroupName":"small","storedBytes":512},{"logGroupName":"large","storedBytes":2048}]}`},
+				},
+			},
+		},
+		Checks: []acctest.ListCheck{
+			acctest.ExpectListDisplayNames("large (2048 bytes)"),
+		},
+	})
+}
+
+// testDescribeLogGroupsPage returns the body of a DescribeLogGroups response listing the named log groups.
+func testDescribeLogGroupsPage(t *testing.T, nextToken string, logGroupNames ...string) string {
+	t.Helper()
+
+	type logGroup struct {
+		ARN          string `json:"arn"`
+		CreationTime int64  `json:"creationTime"`
+		LogGroupARN  string `json:"logGroupArn"`
+		LogGroupName string `json:"logGroupName"`
+	}
+	page := struct {
+		LogGroups []logGroup `json:"logGroups"`
+		NextToken string     `json:"nextToken,omitempty"`
+	}{
+		LogGroups: []logGroup{},
+		NextToken: nextToken,
+	}
+	for _, name := range logGroupNames {
+		arn := fmt.Sprintf("arn:aws:logs:us-west-2:123456789012:log-group:%s", name) //lintignore:AWSAT003,AWSAT005
+		page.LogGroups = append(page.LogGroups, logGroup{
+			ARN:          arn + ":*",
+			CreationTime: 1735689600000,
+			LogGroupARN:  arn,
+			LogGroupName: name,
+		})
+	}
+
+	body, err := json.Marshal(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(body)
+}
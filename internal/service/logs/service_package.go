@@ -13,9 +13,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
+// rateLimiters limit the CloudWatch Logs calls made by list resources.
+// CloudWatch Logs throttles an account's calls in each Region across its APIs rather than per operation,
+// so every Logs call made by a list resource, whatever the operation, waits on the single limiter for its account and Region.
+var rateLimiters = ratelimit.Register("CloudWatchLogs", 5, 5)
+
+// observeThrottling returns an option that slows limiter down whenever a CloudWatch Logs call is throttled.
+func observeThrottling(limiter *ratelimit.Limiter) func(*cloudwatchlogs.Options) {
+	return func(o *cloudwatchlogs.Options) {
+		if r, ok := o.Retryer.(aws.RetryerV2); ok {
+			o.Retryer = limiter.ObserveThrottling(r)
+		}
+	}
+}
+
 func (p *servicePackage) withExtraOptions(ctx context.Context, config map[string]any) []func(*cloudwatchlogs.Options) {
 	cfg := *(config["aws_sdkv2_config"].(*aws.Config))
 
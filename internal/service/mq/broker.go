@@ -43,6 +43,11 @@ import (
 
 // @SDKResource("aws_mq_broker", name="Broker")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mq;mq.DescribeBrokerOutput")
+// @Testing(importIgnore="apply_immediately;user")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceBroker() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBrokerCreate,
@@ -50,10 +55,6 @@ func resourceBroker() *schema.Resource {
 		UpdateWithoutTimeout: resourceBrokerUpdate,
 		DeleteWithoutTimeout: resourceBrokerDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -481,6 +482,14 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		return sdkdiag.AppendErrorf(diags, "reading MQ Broker (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceBrokerFlatten(ctx, conn, output, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceBrokerFlatten(ctx context.Context, conn *mq.Client, output *mq.DescribeBrokerOutput, d *schema.ResourceData) error {
 	d.Set(names.AttrARN, output.BrokerArn)
 	d.Set("authentication_strategy", output.AuthenticationStrategy)
 	d.Set(names.AttrAutoMinorVersionUpgrade, output.AutoMinorVersionUpgrade)
@@ -498,11 +507,11 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	d.Set(names.AttrSubnetIDs, output.SubnetIds)
 
 	if err := d.Set(names.AttrConfiguration, flattenConfiguration(output.Configurations)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+		return fmt.Errorf("setting configuration: %w", err)
 	}
 
 	if err := d.Set("encryption_options", flattenEncryptionOptions(output.EncryptionOptions)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting encryption_options: %s", err)
+		return fmt.Errorf("setting encryption_options: %w", err)
 	}
 
 	var password string
@@ -511,30 +520,30 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	}
 
 	if err := d.Set("ldap_server_metadata", flattenLDAPServerMetadata(output.LdapServerMetadata, password)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ldap_server_metadata: %s", err)
+		return fmt.Errorf("setting ldap_server_metadata: %w", err)
 	}
 
 	if err := d.Set("logs", flattenLogs(output.Logs)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting logs: %s", err)
+		return fmt.Errorf("setting logs: %w", err)
 	}
 
 	if err := d.Set("maintenance_window_start_time", flattenWeeklyStartTime(output.MaintenanceWindowStartTime)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting maintenance_window_start_time: %s", err)
+		return fmt.Errorf("setting maintenance_window_start_time: %w", err)
 	}
 
-	rawUsers, err := expandUsersForBroker(ctx, conn, d.Id(), output.Users)
+	rawUsers, err := expandUsersForBroker(ctx, conn, aws.ToString(output.BrokerId), output.Users)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading MQ Broker (%s) users: %s", d.Id(), err)
+		return fmt.Errorf("reading MQ Broker (%s) users: %w", aws.ToString(output.BrokerId), err)
 	}
 
 	if err := d.Set("user", flattenUsers(rawUsers, d.Get("user").(*schema.Set).List())); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting user: %s", err)
+		return fmt.Errorf("setting user: %w", err)
 	}
 
 	setTagsOut(ctx, output.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
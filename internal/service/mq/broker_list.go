@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mq
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mq/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_mq_broker")
+func newBrokerResourceAsListResource() inttypes.ListResourceForSDK {
+	l := brokerListResource{}
+	l.SetResourceSchema(resourceBroker())
+
+	return &l
+}
+
+var _ list.ListResource = &brokerListResource{}
+
+type brokerListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type brokerListResourceModel struct {
+	framework.WithRegionModel
+	EngineType types.String `tfsdk:"engine_type"`
+}
+
+func (l *brokerListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"engine_type": listschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(enum.Values[awstypes.EngineType]()...),
+				},
+			},
+		},
+	}
+}
+
+func (l *brokerListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.MQClient(ctx)
+
+	var query brokerListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	filter := brokerEngineTypeFilter(query.EngineType)
+
+	tflog.Info(ctx, "Listing MQ Brokers")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := describeBrokerRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input mq.ListBrokersInput
+		for summary, err := range listBrokers(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if !filter(&summary) {
+				continue
+			}
+
+			brokerID := aws.ToString(summary.BrokerId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), brokerID)
+
+			// ListBrokers does not return the engine version, so each broker is described.
+			broker, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (*mq.DescribeBrokerOutput, error) {
+				return findBrokerByID(ctx, conn, brokerID)
+			})
+			if retry.NotFound(err) || errs.IsA[*awstypes.ForbiddenException](err) {
+				continue
+			}
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading MQ Broker (%s): %w", brokerID, err))
+				yield(result)
+				return
+			}
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(brokerID)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading MQ Broker")
+				if err := resourceBrokerFlatten(ctx, conn, broker, rd); err != nil {
+					tflog.Error(ctx, "Reading MQ Broker", map[string]any{
+						"error": err.Error(),
+					})
+					continue
+				}
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(broker.BrokerName), aws.ToString(broker.EngineVersion))
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// brokerEngineTypeFilter returns a predicate selecting brokers with the specified engine type.
+// Engine types are compared case-insensitively. A null value selects all brokers.
+func brokerEngineTypeFilter(engineType types.String) tfslices.Predicate[*awstypes.BrokerSummary] {
+	if engineType.IsNull() || engineType.IsUnknown() {
+		return tfslices.PredicateTrue[*awstypes.BrokerSummary]()
+	}
+
+	return func(v *awstypes.BrokerSummary) bool {
+		return strings.EqualFold(string(v.EngineType), engineType.ValueString())
+	}
+}
+
+func listBrokers(ctx context.Context, conn *mq.Client, input *mq.ListBrokersInput) iter.Seq2[awstypes.BrokerSummary, error] {
+	return func(yield func(awstypes.BrokerSummary, error) bool) {
+		pages := mq.NewListBrokersPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.BrokerSummary{}, fmt.Errorf("listing MQ Brokers: %w", err))
+				return
+			}
+
+			for _, broker := range page.BrokerSummaries {
+				if !yield(broker, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// describeBrokerRateLimiters limit the DescribeBroker calls made to hydrate listed brokers, to 10 per second by default.
+var describeBrokerRateLimiters = ratelimit.Register("mq:DescribeBroker", 10, 10)
@@ -8,6 +8,7 @@ package ram
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -35,6 +36,9 @@ const (
 
 // @SDKResource("aws_ram_resource_share", name="Resource Share")
 // @Tags(identifierAttribute="arn", resourceType="ResourceShare")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ram/types;awstypes;awstypes.ResourceShare")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceResourceShare() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourceShareCreate,
@@ -42,10 +46,6 @@ func resourceResourceShare() *schema.Resource {
 		UpdateWithoutTimeout: resourceResourceShareUpdate,
 		DeleteWithoutTimeout: resourceResourceShareDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
@@ -135,6 +135,14 @@ func resourceResourceShareRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceResourceShareFlatten(ctx, conn, resourceShare, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceResourceShareFlatten(ctx context.Context, conn *ram.Client, resourceShare *awstypes.ResourceShare, d *schema.ResourceData) error {
 	d.Set("allow_external_principals", resourceShare.AllowExternalPrincipals)
 	d.Set(names.AttrARN, resourceShare.ResourceShareArn)
 	d.Set(names.AttrName, resourceShare.Name)
@@ -151,7 +159,7 @@ func resourceResourceShareRead(ctx context.Context, d *schema.ResourceData, meta
 		page, err := pages.NextPage(ctx)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share (%s) permissions: %s", d.Id(), err)
+			return fmt.Errorf("reading RAM Resource Share (%s) permissions: %w", d.Id(), err)
 		}
 
 		permissions = append(permissions, page.Permissions...)
@@ -162,7 +170,7 @@ func resourceResourceShareRead(ctx context.Context, d *schema.ResourceData, meta
 	})
 	d.Set("permission_arns", permissionARNs)
 
-	return diags
+	return nil
 }
 
 func resourceResourceShareUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ram"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_ram_resource_share")
+func newResourceShareResourceAsListResource() inttypes.ListResourceForSDK {
+	l := resourceShareListResource{}
+	l.SetResourceSchema(resourceResourceShare())
+
+	return &l
+}
+
+var _ list.ListResource = &resourceShareListResource{}
+
+type resourceShareListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type resourceShareListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *resourceShareListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RAMClient(ctx)
+
+	var query resourceShareListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	// Only shares owned by this account can be managed by aws_ram_resource_share.
+	input := ram.GetResourceSharesInput{
+		ResourceOwner: awstypes.ResourceOwnerSelf,
+	}
+
+	tflog.Info(ctx, "Listing RAM Resource Shares")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for page, err := range listResourceSharePages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			arns := make([]string, 0, len(page))
+			for _, resourceShare := range page {
+				arns = append(arns, aws.ToString(resourceShare.ResourceShareArn))
+			}
+
+			principalCounts, err := countResourceShareAssociations(ctx, conn, arns, awstypes.ResourceShareAssociationTypePrincipal)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			resourceCounts, err := countResourceShareAssociations(ctx, conn, arns, awstypes.ResourceShareAssociationTypeResource)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			for _, resourceShare := range page {
+				if resourceShare.Status == awstypes.ResourceShareStatusDeleted {
+					continue
+				}
+
+				arn := aws.ToString(resourceShare.ResourceShareArn)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+				ctx = tflog.SetField(ctx, "resource_share_status", resourceShare.Status)
+				ctx = tflog.SetField(ctx, "principal_count", principalCounts[arn])
+				ctx = tflog.SetField(ctx, "resource_count", resourceCounts[arn])
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(arn)
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading RAM Resource Share")
+					if err := resourceResourceShareFlatten(ctx, conn, &resourceShare, rd); err != nil {
+						tflog.Error(ctx, "Reading RAM Resource Share", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+				} else {
+					rd.Set(names.AttrARN, arn)
+					rd.Set(names.AttrName, resourceShare.Name)
+				}
+
+				result.DisplayName = aws.ToString(resourceShare.Name)
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func listResourceSharePages(ctx context.Context, conn *ram.Client, input *ram.GetResourceSharesInput) iter.Seq2[[]awstypes.ResourceShare, error] {
+	return func(yield func([]awstypes.ResourceShare, error) bool) {
+		pages := ram.NewGetResourceSharesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing RAM Resource Shares: %w", err))
+				return
+			}
+
+			if !yield(page.ResourceShares, nil) {
+				return
+			}
+		}
+	}
+}
+
+// countResourceShareAssociations returns the number of associated principals or resources of each of the specified resource shares, keyed by ARN.
+func countResourceShareAssociations(ctx context.Context, conn *ram.Client, arns []string, associationType awstypes.ResourceShareAssociationType) (map[string]int, error) {
+	counts := make(map[string]int, len(arns))
+	if len(arns) == 0 {
+		return counts, nil
+	}
+
+	input := ram.GetResourceShareAssociationsInput{
+		AssociationStatus: awstypes.ResourceShareAssociationStatusAssociated,
+		AssociationType:   associationType,
+		ResourceShareArns: arns,
+	}
+	associations, err := findResourceShareAssociations(ctx, conn, &input)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading RAM Resource Share %s associations: %w", associationType, err)
+	}
+
+	for _, association := range associations {
+		counts[aws.ToString(association.ResourceShareArn)]++
+	}
+
+	return counts, nil
+}
@@ -147,6 +147,18 @@ const (
 	dbSnapshotAttributeNameRestore = "restore"
 )
 
+const (
+	dbSnapshotTypeAutomated = "automated"
+	dbSnapshotTypeManual    = "manual"
+)
+
+func dbSnapshotType_Values() []string {
+	return []string{
+		dbSnapshotTypeAutomated,
+		dbSnapshotTypeManual,
+	}
+}
+
 const (
 	clusterEngineAuroraMySQL      = "aurora-mysql"
 	clusterEngineAuroraPostgreSQL = "aurora-postgresql"
@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -55,8 +56,11 @@ import (
 
 // @SDKResource("aws_db_instance", name="DB Instance")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @CustomImport
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.DBInstance")
 // @Testing(importIgnore="apply_immediately;password")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInstanceCreate,
@@ -1960,6 +1964,12 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "reading RDS DB Instance (%s): %s", d.Get(names.AttrIdentifier).(string), err)
 	}
 
+	return append(diags, resourceInstanceFlatten(ctx, meta.(*conns.AWSClient), v, d)...)
+}
+
+func resourceInstanceFlatten(ctx context.Context, awsClient *conns.AWSClient, v *types.DBInstance, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	d.SetId(aws.ToString(v.DbiResourceId))
 	d.Set(names.AttrAllocatedStorage, v.AllocatedStorage)
 	d.Set(names.AttrARN, v.DBInstanceArn)
@@ -2061,7 +2071,6 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 			original := original.(string)
 			if arn.IsARN(original) {
 				if !arn.IsARN(sourceDBIdentifier) {
-					awsClient := meta.(*conns.AWSClient)
 					sourceDBIdentifier = newDBInstanceARNString(ctx, awsClient, sourceDBIdentifier)
 				}
 			}
@@ -2426,7 +2435,11 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta an
 	return diags
 }
 
-func resourceInstanceImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+func resourceInstanceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := importer.Import(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
 	// Neither skip_final_snapshot nor final_snapshot_identifier can be fetched
 	// from any API call, so we need to default skip_final_snapshot to true so
 	// that final_snapshot_identifier is not required.
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_db_instance")
+func newInstanceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := listResourceInstance{}
+	l.SetResourceSchema(resourceInstance())
+	return &l
+}
+
+var _ list.ListResource = &listResourceInstance{}
+
+type listResourceInstance struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *listResourceInstance) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"exclude_read_replicas": listschema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *listResourceInstance) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().RDSClient(ctx)
+
+	var query listInstanceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	filter := tfslices.PredicateTrue[*awstypes.DBInstance]()
+	if query.ExcludeReadReplicas.ValueBool() {
+		filter = func(v *awstypes.DBInstance) bool {
+			return aws.ToString(v.ReadReplicaSourceDBInstanceIdentifier) == ""
+		}
+	}
+
+	tflog.Info(ctx, "Listing RDS DB Instance")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input rds.DescribeDBInstancesInput
+		for item, err := range listDBInstances(ctx, conn, &input, filter) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			id := aws.ToString(item.DbiResourceId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(id)
+			rd.Set(names.AttrIdentifier, item.DBInstanceIdentifier)
+
+			if request.IncludeResource {
+				// DescribeDBInstances already returns the whole instance, including its TagList,
+				// so the instance is flattened from the page rather than read again.
+				diags := resourceInstanceFlatten(ctx, l.Meta(), &item, rd)
+				if diags.HasError() {
+					tflog.Error(ctx, "Flattening RDS DB Instance", map[string]any{
+						names.AttrID: id,
+						"diags":      sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(item.DBInstanceIdentifier), aws.ToString(item.EngineVersion))
+
+			l.SetResult(ctx, l.Meta(), request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type listInstanceModel struct {
+	framework.WithRegionModel
+	ExcludeReadReplicas types.Bool `tfsdk:"exclude_read_replicas"`
+}
+
+func listDBInstances(ctx context.Context, conn *rds.Client, input *rds.DescribeDBInstancesInput, filter tfslices.Predicate[*awstypes.DBInstance]) iter.Seq2[awstypes.DBInstance, error] {
+	return func(yield func(awstypes.DBInstance, error) bool) {
+		pages := rds.NewDescribeDBInstancesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBInstance{}, fmt.Errorf("listing RDS DB Instance resources: %w", err))
+				return
+			}
+
+			for _, item := range page.DBInstances {
+				if filter(&item) {
+					if !yield(item, nil) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_db_parameter_group")
+func newParameterGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := parameterGroupListResource{}
+	l.SetResourceSchema(resourceParameterGroup())
+
+	return &l
+}
+
+var _ list.ListResource = &parameterGroupListResource{}
+
+type parameterGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type parameterGroupListResourceModel struct {
+	framework.WithRegionModel
+	ExcludeDefault types.Bool `tfsdk:"exclude_default"`
+}
+
+func (l *parameterGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"exclude_default": listschema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *parameterGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	var query parameterGroupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	filter := parameterGroupExcludeDefaultFilter(query.ExcludeDefault)
+
+	tflog.Info(ctx, "Listing RDS DB Parameter Groups")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := listTagsForResourceRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input rds.DescribeDBParameterGroupsInput
+		for page, err := range listDBParameterGroupPages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			page = tfslices.Filter(page, filter)
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				arns := make([]string, 0, len(page))
+				for _, parameterGroup := range page {
+					arns = append(arns, aws.ToString(parameterGroup.DBParameterGroupArn))
+				}
+
+				tags, err = findDBParameterGroupTags(ctx, conn, limiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, parameterGroup := range page {
+				name := aws.ToString(parameterGroup.DBParameterGroupName)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), name)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(name)
+				rd.Set(names.AttrName, name)
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading RDS DB Parameter Group")
+					if err := resourceParameterGroupFlatten(ctx, conn, &parameterGroup, rd); err != nil {
+						tflog.Error(ctx, "Reading RDS DB Parameter Group", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tags[aws.ToString(parameterGroup.DBParameterGroupArn)]))
+				}
+
+				result.DisplayName = fmt.Sprintf("%s (%s)", name, aws.ToString(parameterGroup.DBParameterGroupFamily))
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// parameterGroupExcludeDefaultFilter returns a predicate excluding the AWS-managed "default.*" parameter groups.
+// A null or false value selects all parameter groups.
+func parameterGroupExcludeDefaultFilter(excludeDefault types.Bool) tfslices.Predicate[awstypes.DBParameterGroup] {
+	if !excludeDefault.ValueBool() {
+		return tfslices.PredicateTrue[awstypes.DBParameterGroup]()
+	}
+
+	return func(v awstypes.DBParameterGroup) bool {
+		return !strings.HasPrefix(aws.ToString(v.DBParameterGroupName), "default.")
+	}
+}
+
+func listDBParameterGroupPages(ctx context.Context, conn *rds.Client, input *rds.DescribeDBParameterGroupsInput) iter.Seq2[[]awstypes.DBParameterGroup, error] {
+	return func(yield func([]awstypes.DBParameterGroup, error) bool) {
+		pages := rds.NewDescribeDBParameterGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing RDS DB Parameter Groups: %w", err))
+				return
+			}
+
+			if !yield(page.DBParameterGroups, nil) {
+				return
+			}
+		}
+	}
+}
+
+// findDBParameterGroupTags returns the tags of each of the specified DB parameter groups, keyed by ARN.
+func findDBParameterGroupTags(ctx context.Context, conn *rds.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if errs.IsA[*awstypes.DBParameterGroupNotFoundFault](err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for RDS DB Parameter Group (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
@@ -29,7 +29,11 @@ import (
 
 // @SDKResource("aws_db_snapshot", name="DB Snapshot")
 // @Tags(identifierAttribute="db_snapshot_arn")
+// @IdentityAttribute("db_snapshot_identifier")
+// @Testing(idAttrDuplicates="db_snapshot_identifier")
 // @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.DBSnapshot")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceSnapshot() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSnapshotCreate,
@@ -37,10 +41,6 @@ func resourceSnapshot() *schema.Resource {
 		UpdateWithoutTimeout: resourceSnapshotUpdate,
 		DeleteWithoutTimeout: resourceSnapshotDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 		},
@@ -191,6 +191,16 @@ func resourceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "reading RDS DB Snapshot (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceSnapshotFlatten(ctx, conn, snapshot, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	setTagsOut(ctx, snapshot.TagList)
+
+	return diags
+}
+
+func resourceSnapshotFlatten(ctx context.Context, conn *rds.Client, snapshot *types.DBSnapshot, d *schema.ResourceData) error {
 	arn := aws.ToString(snapshot.DBSnapshotArn)
 	d.Set(names.AttrAllocatedStorage, snapshot.AllocatedStorage)
 	d.Set(names.AttrAvailabilityZone, snapshot.AvailabilityZone)
@@ -211,18 +221,16 @@ func resourceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta any)
 	d.Set(names.AttrStatus, snapshot.Status)
 	d.Set(names.AttrVPCID, snapshot.VpcId)
 
-	attribute, err := findDBSnapshotAttributeByTwoPartKey(ctx, conn, d.Id(), dbSnapshotAttributeNameRestore)
+	attribute, err := findDBSnapshotAttributeByTwoPartKey(ctx, conn, aws.ToString(snapshot.DBSnapshotIdentifier), dbSnapshotAttributeNameRestore)
 	switch {
 	case err == nil:
 		d.Set("shared_accounts", attribute.AttributeValues)
 	case retry.NotFound(err):
 	default:
-		return sdkdiag.AppendErrorf(diags, "reading RDS DB Snapshot (%s) attribute: %s", d.Id(), err)
+		return fmt.Errorf("reading RDS DB Snapshot (%s) attribute: %w", aws.ToString(snapshot.DBSnapshotIdentifier), err)
 	}
 
-	setTagsOut(ctx, snapshot.TagList)
-
-	return diags
+	return nil
 }
 
 func resourceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
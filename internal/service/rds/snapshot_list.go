@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_db_snapshot")
+func newSnapshotResourceAsListResource() inttypes.ListResourceForSDK {
+	l := snapshotListResource{}
+	l.SetResourceSchema(resourceSnapshot())
+
+	return &l
+}
+
+var _ list.ListResource = &snapshotListResource{}
+
+type snapshotListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type snapshotListResourceModel struct {
+	framework.WithRegionModel
+	SnapshotType types.String `tfsdk:"snapshot_type"`
+}
+
+func (l *snapshotListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"snapshot_type": listschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dbSnapshotType_Values()...),
+				},
+			},
+		},
+	}
+}
+
+func (l *snapshotListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	var query snapshotListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing RDS DB Snapshots")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := listTagsForResourceRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		input := rds.DescribeDBSnapshotsInput{
+			SnapshotType: fwflex.StringFromFramework(ctx, query.SnapshotType),
+		}
+		for page, err := range listDBSnapshotPages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				arns := make([]string, 0, len(page))
+				for _, snapshot := range page {
+					arns = append(arns, aws.ToString(snapshot.DBSnapshotArn))
+				}
+
+				tags, err = findDBSnapshotTags(ctx, conn, limiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, snapshot := range page {
+				id := aws.ToString(snapshot.DBSnapshotIdentifier)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(id)
+				rd.Set("db_snapshot_identifier", id)
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading RDS DB Snapshot")
+					if err := resourceSnapshotFlatten(ctx, conn, &snapshot, rd); err != nil {
+						tflog.Error(ctx, "Reading RDS DB Snapshot", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tags[aws.ToString(snapshot.DBSnapshotArn)]))
+				}
+
+				result.DisplayName = fmt.Sprintf("%s (%s)", id, aws.ToTime(snapshot.SnapshotCreateTime).Format(time.RFC3339))
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func listDBSnapshotPages(ctx context.Context, conn *rds.Client, input *rds.DescribeDBSnapshotsInput) iter.Seq2[[]awstypes.DBSnapshot, error] {
+	return func(yield func([]awstypes.DBSnapshot, error) bool) {
+		pages := rds.NewDescribeDBSnapshotsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing RDS DB Snapshots: %w", err))
+				return
+			}
+
+			if !yield(page.DBSnapshots, nil) {
+				return
+			}
+		}
+	}
+}
+
+// findDBSnapshotTags returns the tags of each of the specified DB snapshots, keyed by ARN.
+// ListTagsForResource accepts a single ARN, so it is called once per snapshot.
+func findDBSnapshotTags(ctx context.Context, conn *rds.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if errs.IsA[*awstypes.DBSnapshotNotFoundFault](err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for RDS DB Snapshot (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
+
+// listTagsForResourceRateLimiters limit the ListTagsForResource calls made to hydrate listed DB snapshots, parameter groups and subnet groups,
+// to 10 per second by default.
+var listTagsForResourceRateLimiters = ratelimit.Register("rds:ListTagsForResource", 10, 10)
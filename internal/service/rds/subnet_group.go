@@ -29,7 +29,12 @@ import (
 
 // @SDKResource("aws_db_subnet_group", name="DB Subnet Group")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(name="SubnetGroup")
+// @Testing(idAttrDuplicates="name")
 // @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.DBSubnetGroup")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceSubnetGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSubnetGroupCreate,
@@ -37,10 +42,6 @@ func resourceSubnetGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceSubnetGroupUpdate,
 		DeleteWithoutTimeout: resourceSubnetGroupDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -126,6 +127,12 @@ func resourceSubnetGroupRead(ctx context.Context, d *schema.ResourceData, meta a
 		return sdkdiag.AppendErrorf(diags, "reading RDS DB Subnet Group (%s): %s", d.Id(), err)
 	}
 
+	resourceSubnetGroupFlatten(ctx, v, d)
+
+	return diags
+}
+
+func resourceSubnetGroupFlatten(_ context.Context, v *types.DBSubnetGroup, d *schema.ResourceData) {
 	d.Set(names.AttrARN, v.DBSubnetGroupArn)
 	d.Set(names.AttrDescription, v.DBSubnetGroupDescription)
 	d.Set(names.AttrName, v.DBSubnetGroupName)
@@ -135,8 +142,6 @@ func resourceSubnetGroupRead(ctx context.Context, d *schema.ResourceData, meta a
 	}))
 	d.Set("supported_network_types", v.SupportedNetworkTypes)
 	d.Set(names.AttrVPCID, v.VpcId)
-
-	return diags
 }
 
 func resourceSubnetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
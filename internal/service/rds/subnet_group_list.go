@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_db_subnet_group")
+func newSubnetGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := subnetGroupListResource{}
+	l.SetResourceSchema(resourceSubnetGroup())
+
+	return &l
+}
+
+var _ list.ListResource = &subnetGroupListResource{}
+
+type subnetGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type subnetGroupListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *subnetGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	var query subnetGroupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing RDS DB Subnet Groups")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := listTagsForResourceRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input rds.DescribeDBSubnetGroupsInput
+		if request.Limit > 0 && request.Limit < describeDBSubnetGroupsMaxRecords {
+			input.MaxRecords = aws.Int32(int32(max(request.Limit, describeDBSubnetGroupsMinRecords)))
+		}
+
+		var count int64
+		for page, err := range listDBSubnetGroupPages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if request.Limit > 0 {
+				if remaining := request.Limit - count; int64(len(page)) > remaining {
+					page = page[:remaining]
+				}
+			}
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				arns := make([]string, 0, len(page))
+				for _, subnetGroup := range page {
+					arns = append(arns, aws.ToString(subnetGroup.DBSubnetGroupArn))
+				}
+
+				tags, err = findDBSubnetGroupTags(ctx, conn, limiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, subnetGroup := range page {
+				name := aws.ToString(subnetGroup.DBSubnetGroupName)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), name)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(name)
+				rd.Set(names.AttrName, name)
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading RDS DB Subnet Group")
+					// DescribeDBSubnetGroups returns the subnet membership inline.
+					resourceSubnetGroupFlatten(ctx, &subnetGroup, rd)
+
+					setTagsOut(ctx, svcTags(tags[aws.ToString(subnetGroup.DBSubnetGroupArn)]))
+				}
+
+				result.DisplayName = fmt.Sprintf("%s (%s)", name, aws.ToString(subnetGroup.VpcId))
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				count++
+				if !yield(result) {
+					return
+				}
+			}
+
+			if request.Limit > 0 && count >= request.Limit {
+				return
+			}
+		}
+	}
+}
+
+const (
+	// The bounds on the number of subnet groups that DescribeDBSubnetGroups returns in a single page.
+	describeDBSubnetGroupsMinRecords = 20
+	describeDBSubnetGroupsMaxRecords = 100
+)
+
+func listDBSubnetGroupPages(ctx context.Context, conn *rds.Client, input *rds.DescribeDBSubnetGroupsInput) iter.Seq2[[]awstypes.DBSubnetGroup, error] {
+	return func(yield func([]awstypes.DBSubnetGroup, error) bool) {
+		pages := rds.NewDescribeDBSubnetGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing RDS DB Subnet Groups: %w", err))
+				return
+			}
+
+			if !yield(page.DBSubnetGroups, nil) {
+				return
+			}
+		}
+	}
+}
+
+// findDBSubnetGroupTags returns the tags of each of the specified DB subnet groups, keyed by ARN.
+func findDBSubnetGroupTags(ctx context.Context, conn *rds.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if errs.IsA[*awstypes.DBSubnetGroupNotFoundFault](err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for RDS DB Subnet Group (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
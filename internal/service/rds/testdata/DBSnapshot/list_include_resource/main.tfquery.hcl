@@ -0,0 +1,12 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_db_snapshot" "test" {
+  provider = aws
+
+  config {
+    snapshot_type = "manual"
+  }
+
+  include_resource = true
+}
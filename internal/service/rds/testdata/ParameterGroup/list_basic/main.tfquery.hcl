@@ -0,0 +1,10 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_db_parameter_group" "test" {
+  provider = aws
+
+  config {
+    exclude_default = true
+  }
+}
@@ -5,18 +5,39 @@ package s3
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"iter"
+	"maps"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -46,72 +67,430 @@ func (l *listResourceBucket) List(ctx context.Context, request list.ListRequest,
 		}
 	}
 
+	region, diags := listBucketsRegion(l.Meta().Region(ctx), query.BucketRegion.ValueString(), query.AllRegions.ValueBool())
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	nameFilter, diags := query.NamePredicate()
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	displayNameTemplate, diags := query.ParseDisplayNameTemplate()
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var tags map[string]string
+	if !query.Tags.IsNull() {
+		if diags := query.Tags.ElementsAs(ctx, &tags, false); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	concurrency := listBucketsDefaultConcurrency
+	if !query.Concurrency.IsNull() {
+		concurrency = int(query.Concurrency.ValueInt64())
+	}
+
+	export, diags := query.OpenExport()
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	csvExport, diags := query.OpenCSVExport()
+	if diags.HasError() {
+		_ = export.Close()
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	importBlocks := query.NewImportBlocks("aws_s3_bucket")
+
 	tflog.Info(ctx, "Listing S3 Bucket")
 	stream.Results = func(yield func(list.ListResult) bool) {
-		input := s3.ListBucketsInput{
-			BucketRegion: aws.String(l.Meta().Region(ctx)),
-			MaxBuckets:   aws.Int32(int32(request.Limit)),
-		}
-		for item, err := range listBuckets(ctx, conn, &input) {
-			if err != nil {
-				result := fwdiag.NewListResultErrorDiagnostic(err)
-				yield(result)
-				return
+		// Buckets are listed with the context passed by MappedConcurrently, so that listing stops as soon as reading does.
+		listed := func(ctx context.Context) iter.Seq[listedBucket] {
+			var buckets iter.Seq2[awstypes.Bucket, error]
+			if len(tags) > 0 {
+				// Only buckets with all of the tags are listed.
+				buckets = listTaggedBuckets(ctx, l.Meta().ResourceGroupsTaggingAPIClient(ctx), l.Meta().RateLimitScope(ctx), tags)
+			} else {
+				input := newListBucketsInput(region, request.Limit)
+				input.ContinuationToken = query.StartToken.ValueStringPointer()
+				buckets = listBuckets(ctx, conn, &input)
 			}
 
-			bucketName := aws.ToString(item.Name)
-			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrBucket), bucketName)
+			return func(yield func(listedBucket) bool) {
+				for item, err := range buckets {
+					// Buckets are filtered by name before they are read.
+					if err == nil && !nameFilter(aws.ToString(item.Name)) {
+						continue
+					}
+					if !yield(listedBucket{bucket: item, err: err}) {
+						return
+					}
+				}
+			}
+		}
 
-			result := request.NewListResult(ctx)
-			rd := l.ResourceData()
-			rd.SetId(bucketName)
-			rd.Set(names.AttrBucket, bucketName)
-
-			tflog.Info(ctx, "Reading S3 Bucket")
-			diags := resourceBucketRead(ctx, rd, l.Meta())
-			if diags.HasError() {
-				tflog.Error(ctx, "Reading S3 Bucket", map[string]any{
-					names.AttrBucket: bucketName,
-					"diags":          sdkdiag.DiagnosticsString(diags),
-				})
-				continue
+		// Buckets are read concurrently, but results are returned in the order in which buckets are listed.
+		hydrated := tfiter.MappedConcurrently(ctx, listed, concurrency, func(ctx context.Context, item listedBucket) hydratedBucket {
+			return l.hydrateBucket(ctx, item, query)
+		})
+		// MaxBuckets only sets the page size, and buckets are filtered after they are listed,
+		// so the limit is applied to the buckets that are read.
+		if request.Limit > 0 {
+			hydrated = limitHydratedBuckets(hydrated, request.Limit)
+		}
+		for bucket := range hydrated {
+			if bucket.err != nil {
+				result := framework.NewResumableListResultErrorDiagnostic(bucket.err)
+				yield(result)
+				return
 			}
-			if rd.Id() == "" {
-				// Resource is logically deleted
+			if bucket.rd == nil {
 				continue
 			}
 
-			result.DisplayName = bucketName
+			ctx := bucket.ctx
+			result := request.NewListResult(ctx)
+			displayName, err := l.DisplayName(displayNameTemplate, bucket.rd, l.Meta().Region(ctx), bucket.rd.Id())
+			if err != nil {
+				yield(fwdiag.NewListResultErrorDiagnostic(err))
+				return
+			}
+			result.DisplayName = displayName
 
-			l.SetResult(ctx, l.Meta(), request.IncludeResource, &result, rd)
+			l.SetResult(ctx, l.Meta(), request.IncludeResource, &result, bucket.rd)
 			if result.Diagnostics.HasError() {
 				yield(result)
 				return
 			}
 
+			record := l.ExportRecord(bucket.rd, l.Meta().Region(ctx), displayName)
+			export.Add(record)
+			csvExport.Add(record)
+			importBlocks.Add(bucket.rd.Id(), bucket.rd.Id(), l.Meta().Region(ctx))
+
 			if !yield(result) {
 				return
 			}
 		}
 	}
+	stream.Results = importBlocks.WriteAfter(stream.Results)
+	stream.Results = export.CloseAfter(stream.Results)
+	stream.Results = csvExport.CloseAfter(stream.Results)
+}
+
+type listedBucket struct {
+	bucket awstypes.Bucket
+	err    error
+}
+
+// hydratedBucket is a listed bucket read into resource data.
+// rd is nil if the bucket is skipped.
+type hydratedBucket struct {
+	ctx context.Context
+	rd  *schema.ResourceData
+	err error
+}
+
+// limitHydratedBuckets truncates buckets to limit read buckets in total.
+// Skipped buckets don't count towards the limit.
+func limitHydratedBuckets(buckets iter.Seq[hydratedBucket], limit int64) iter.Seq[hydratedBucket] {
+	return func(yield func(hydratedBucket) bool) {
+		var count int64
+		for bucket := range buckets {
+			if !yield(bucket) || bucket.err != nil {
+				return
+			}
+			if bucket.rd != nil {
+				count++
+			}
+
+			// Stop before any more buckets are listed or read.
+			if count >= limit {
+				return
+			}
+		}
+	}
+}
+
+// hydrateBucket reads a listed bucket into resource data.
+// Buckets that can't be read are logged and skipped rather than failing the whole list.
+// Buckets that don't match the query's post-read filters are skipped.
+func (l *listResourceBucket) hydrateBucket(ctx context.Context, item listedBucket, query listBucketModel) hydratedBucket {
+	if item.err != nil {
+		return hydratedBucket{err: item.err}
+	}
+
+	bucketName := aws.ToString(item.bucket.Name)
+	ctx = tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrBucket), bucketName)
+
+	if query.AllRegions.ValueBool() {
+		bucketRegion, err := listedBucketRegion(ctx, l.Meta(), item.bucket)
+		if retry.NotFound(err) {
+			return hydratedBucket{}
+		}
+		if err != nil {
+			tflog.Error(ctx, "Reading S3 Bucket Region", map[string]any{
+				names.AttrBucket: bucketName,
+				"error":          err.Error(),
+			})
+			return hydratedBucket{}
+		}
+
+		// Hydrate the bucket, and set its identity, in the bucket's own Region.
+		ctx = framework.WithOverrideRegion(ctx, bucketRegion)
+	} else if region := query.BucketRegion.ValueString(); region != "" {
+		// Buckets homed in another Region are read in that Region.
+		ctx = framework.WithOverrideRegion(ctx, region)
+	}
+
+	rd := l.ResourceData()
+	rd.SetId(bucketName)
+	rd.Set(names.AttrBucket, bucketName)
+
+	tflog.Info(ctx, "Reading S3 Bucket")
+	diags := resourceBucketRead(ctx, rd, l.Meta())
+	if diags.HasError() {
+		tflog.Error(ctx, "Reading S3 Bucket", map[string]any{
+			names.AttrBucket: bucketName,
+			"diags":          sdkdiag.DiagnosticsString(diags),
+		})
+		return hydratedBucket{}
+	}
+	if rd.Id() == "" {
+		// Resource is logically deleted
+		return hydratedBucket{}
+	}
+
+	if query.MissingPublicAccessBlock.ValueBool() {
+		// ListBuckets doesn't return public access block configuration, so it's read for each bucket.
+		conf, err := findPublicAccessBlockConfiguration(ctx, l.Meta().S3Client(ctx), bucketName)
+		if err != nil && !retry.NotFound(err) {
+			tflog.Error(ctx, "Reading S3 Bucket Public Access Block", map[string]any{
+				names.AttrBucket: bucketName,
+				"error":          err.Error(),
+			})
+			return hydratedBucket{}
+		}
+		if !isPublicAccessBlockMissing(conf) {
+			return hydratedBucket{}
+		}
+	}
+
+	return hydratedBucket{
+		ctx: ctx,
+		rd:  rd,
+	}
+}
+
+func (l *listResourceBucket) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"all_regions": listschema.BoolAttribute{
+				Optional: true,
+			},
+			"bucket_region": listschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					fwvalidators.AWSRegion(),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("all_regions"),
+						path.MatchRoot(names.AttrTags),
+					),
+				},
+			},
+			"concurrency": listschema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"csv_export_path":        framework.CSVExportPathAttribute(),
+			"display_name_template":  framework.DisplayNameTemplateAttribute(),
+			"export_path":            framework.ExportPathAttribute(),
+			"generate_import_blocks": framework.GenerateImportBlocksAttribute(),
+			"import_blocks_path":     framework.ImportBlocksPathAttribute(),
+			"missing_public_access_block": listschema.BoolAttribute{
+				Optional: true,
+			},
+			"name_regex": framework.NameRegexAttribute(),
+			// Tokens are only valid for ListBuckets.
+			"start_token": framework.StartTokenAttribute(
+				path.MatchRoot(names.AttrTags),
+			),
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(path.MatchRoot("all_regions")),
+				},
+			},
+		},
+	}
 }
 
 type listBucketModel struct {
 	framework.WithRegionModel
+	framework.WithCSVExportModel
+	framework.WithDisplayNameTemplateModel
+	framework.WithExportModel
+	framework.WithImportBlocksModel
+	framework.WithNameRegexModel
+	framework.WithStartTokenModel
+	AllRegions               types.Bool   `tfsdk:"all_regions"`
+	BucketRegion             types.String `tfsdk:"bucket_region"`
+	Concurrency              types.Int64  `tfsdk:"concurrency"`
+	MissingPublicAccessBlock types.Bool   `tfsdk:"missing_public_access_block"`
+	Tags                     types.Map    `tfsdk:"tags"`
 }
 
-func listBuckets(ctx context.Context, conn *s3.Client, input *s3.ListBucketsInput) iter.Seq2[awstypes.Bucket, error] {
+const (
+	// The maximum number of buckets that ListBuckets returns in a single page.
+	listBucketsMaxBuckets = 10000
+
+	// The default number of buckets that are read concurrently.
+	listBucketsDefaultConcurrency = 10
+)
+
+// errListBucketsRegionRequired is returned when buckets are listed in the provider's Region, but it has none.
+var errListBucketsRegionRequired = errors.New("provider region is required for listing S3 buckets")
+
+// listBucketsRegion returns the Region in which to list buckets, given the effective Region,
+// the configured bucket Region and whether to list in all Regions.
+// Bucket names are global, so listing in all Regions omits the Region filter.
+// A configured bucket Region overrides the effective Region.
+// Otherwise an empty Region is an error, rather than silently listing buckets in every Region.
+func listBucketsRegion(region, bucketRegion string, allRegions bool) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if allRegions {
+		return "", diags
+	}
+
+	if bucketRegion != "" {
+		return bucketRegion, diags
+	}
+
+	if region == "" {
+		diags.AddAttributeError(
+			path.Root(names.AttrRegion),
+			"Missing Region",
+			fmt.Sprintf("%s. Set region in the provider configuration or in the list block, set bucket_region, or set all_regions to true.", errListBucketsRegionRequired),
+		)
+		return "", diags
+	}
+
+	return region, diags
+}
+
+// newListBucketsInput returns the ListBuckets input for buckets in the specified Region.
+// An empty Region lists buckets in all Regions.
+// MaxBuckets is the page size, which is no larger than a non-zero limit on the number of results.
+// A limit of zero leaves MaxBuckets unset, and limits above the API maximum are capped.
+// The limit itself is enforced as buckets are read.
+func newListBucketsInput(region string, limit int64) s3.ListBucketsInput {
+	var input s3.ListBucketsInput
+	if region != "" {
+		input.BucketRegion = aws.String(region)
+	}
+	if limit > 0 {
+		input.MaxBuckets = aws.Int32(int32(min(limit, listBucketsMaxBuckets)))
+	}
+
+	return input
+}
+
+// isPublicAccessBlockMissing returns whether a bucket's public access block configuration blocks nothing.
+// A nil configuration is a bucket without a public access block.
+func isPublicAccessBlockMissing(conf *awstypes.PublicAccessBlockConfiguration) bool {
+	if conf == nil {
+		return true
+	}
+
+	return !aws.ToBool(conf.BlockPublicAcls) &&
+		!aws.ToBool(conf.BlockPublicPolicy) &&
+		!aws.ToBool(conf.IgnorePublicAcls) &&
+		!aws.ToBool(conf.RestrictPublicBuckets)
+}
+
+// listedBucketRegion returns the Region of a bucket returned by ListBuckets.
+// Implementations that don't return the bucket's Region fall back to looking it up.
+func listedBucketRegion(ctx context.Context, c *conns.AWSClient, bucket awstypes.Bucket) (string, error) {
+	if v := aws.ToString(bucket.BucketRegion); v != "" {
+		return v, nil
+	}
+
+	return findBucketRegion(ctx, c, aws.ToString(bucket.Name))
+}
+
+func listBuckets(ctx context.Context, conn s3.ListBucketsAPIClient, input *s3.ListBucketsInput) iter.Seq2[awstypes.Bucket, error] {
 	return func(yield func(awstypes.Bucket, error) bool) {
-		output, err := conn.ListBuckets(ctx, input)
-		if err != nil {
-			yield(awstypes.Bucket{}, fmt.Errorf("listing S3 Bucket resources: %w", err))
-			return
+		// Each page is yielded as soon as it is returned so that results stream while
+		// the next page is still to be requested.
+		pages := s3.NewListBucketsPaginator(conn, input)
+		// Errors carry the continuation token with which the page that failed was requested, so that listing can be resumed.
+		token := aws.ToString(input.ContinuationToken)
+		for pages.HasMorePages() {
+			// No further pages are requested once listing has been canceled.
+			if err := ctx.Err(); err != nil {
+				yield(awstypes.Bucket{}, &framework.StartTokenError{Err: fmt.Errorf("listing S3 Bucket resources: %w", err), StartToken: token})
+				return
+			}
+
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.Bucket{}, &framework.StartTokenError{Err: fmt.Errorf("listing S3 Bucket resources: %w", err), StartToken: token})
+				return
+			}
+
+			for _, item := range page.Buckets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			token = aws.ToString(page.ContinuationToken)
 		}
+	}
+}
 
-		for _, item := range output.Buckets {
-			if !yield(item, nil) {
+// listTaggedBuckets returns an iterator over the buckets in the scope's Region that have all of the specified tags.
+// Buckets are listed with the Resource Groups Tagging API rather than ListBuckets.
+func listTaggedBuckets(ctx context.Context, conn resourcegroupstaggingapi.GetResourcesAPIClient, scope ratelimit.Scope, tags map[string]string) iter.Seq2[awstypes.Bucket, error] {
+	return func(yield func(awstypes.Bucket, error) bool) {
+		for tagged, err := range tftags.ListResourceTagsByTagFilter(ctx, conn, scope, "s3", tags) {
+			if err != nil {
+				yield(awstypes.Bucket{}, fmt.Errorf("listing tagged S3 Bucket resources: %w", err))
 				return
 			}
+
+			for _, v := range slices.Sorted(maps.Keys(tagged)) {
+				arn, err := arn.Parse(v)
+				if err != nil {
+					continue
+				}
+				// Only bucket ARNs, arn:${Partition}:s3:::${BucketName}, have no Region and a resource without a path.
+				if arn.Region != "" || strings.ContainsAny(arn.Resource, "/:") {
+					continue
+				}
+
+				bucket := awstypes.Bucket{
+					BucketRegion: aws.String(scope.Region),
+					Name:         aws.String(arn.Resource),
+				}
+				if !yield(bucket, nil) {
+					return
+				}
+			}
 		}
 	}
 }
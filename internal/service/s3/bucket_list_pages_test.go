@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"errors"
+	"iter"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLimitHydratedBuckets(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		buckets      []string // Empty names are skipped buckets.
+		limit        int64
+		want         []string
+		wantConsumed int
+	}{
+		"fewer than limit": {
+			buckets:      []string{"a", "b"},
+			limit:        5,
+			want:         []string{"a", "b"},
+			wantConsumed: 2,
+		},
+		"limit reached": {
+			buckets:      []string{"a", "b", "c", "d", "e"},
+			limit:        2,
+			want:         []string{"a", "b"},
+			wantConsumed: 2,
+		},
+		"skipped buckets not counted": {
+			buckets:      []string{"", "a", "", "b", "c"},
+			limit:        2,
+			want:         []string{"", "a", "", "b"},
+			wantConsumed: 4,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var consumed int
+			buckets := func(yield func(hydratedBucket) bool) {
+				for _, name := range testCase.buckets {
+					consumed++
+					if !yield(testHydratedBucket(t, name)) {
+						return
+					}
+				}
+			}
+
+			got := hydratedBucketNames(limitHydratedBuckets(buckets, testCase.limit))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+			if consumed != testCase.wantConsumed {
+				t.Errorf("expected %d buckets to be consumed, got %d", testCase.wantConsumed, consumed)
+			}
+		})
+	}
+}
+
+func TestLimitHydratedBuckets_error(t *testing.T) {
+	t.Parallel()
+
+	buckets := func(yield func(hydratedBucket) bool) {
+		if !yield(testHydratedBucket(t, "a")) {
+			return
+		}
+		if !yield(hydratedBucket{err: errors.New("test")}) {
+			return
+		}
+		t.Error("expected no buckets to be consumed after an error")
+	}
+
+	var got []hydratedBucket
+	for bucket := range limitHydratedBuckets(buckets, 5) {
+		got = append(got, bucket)
+	}
+
+	if len(got) != 2 || got[1].err == nil {
+		t.Errorf("expected the error to be the last of 2 buckets, got %v", got)
+	}
+}
+
+// testHydratedBucket returns a read bucket with the specified name, or a skipped bucket if the name is empty.
+func testHydratedBucket(t *testing.T, name string) hydratedBucket {
+	t.Helper()
+
+	if name == "" {
+		return hydratedBucket{}
+	}
+
+	rd := resourceBucket().TestResourceData()
+	rd.SetId(name)
+
+	return hydratedBucket{
+		ctx: t.Context(),
+		rd:  rd,
+	}
+}
+
+// hydratedBucketNames returns the names of buckets, with an empty name for each skipped bucket.
+func hydratedBucketNames(buckets iter.Seq[hydratedBucket]) []string {
+	var names []string
+	for bucket := range buckets {
+		var name string
+		if bucket.rd != nil {
+			name = bucket.rd.Id()
+		}
+		names = append(names, name)
+	}
+
+	return names
+}
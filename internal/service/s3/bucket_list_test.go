@@ -4,8 +4,21 @@
 package s3_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	taggingtypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -19,6 +32,8 @@ import (
 	tfqueryfilter "github.com/hashicorp/terraform-provider-aws/internal/acctest/queryfilter"
 	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -232,3 +247,411 @@ func TestAccS3Bucket_List_regionOverride(t *testing.T) {
 		},
 	})
 }
+
+func TestS3Bucket_List_pages(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// Each bucket is read, so calls for optional bucket configuration aren't answered.
+	acctest.ListTest(ctx, t, acctest.ListTestCase{
+		ListResourceType: "aws_s3_bucket",
+		API: acctest.ListFakeAPI{
+			Responses: map[string][]acctest.ListFakeResponse{
+				"ListBuckets": {
+					{Body: testListBucketsPage("1", "bucket-0", "bucket-1")},
+					{Body: testListBucketsPage("2", "bucket-2")},
+					{Body: testListBucketsPage("", "bucket-3")},
+				},
+				"HeadBucket": {
+					{Headers: map[string]string{"X-Amz-Bucket-Region": "us-west-2"}}, //lintignore:AWSAT003
+				},
+			},
+			Fallback: &acctest.ListFakeResponse{
+				StatusCode: http.StatusMethodNotAllowed,
+				ErrorCode:  "MethodNotAllowed",
+			},
+		},
+		Checks: []acctest.ListCheck{
+			acctest.ExpectListDisplayNames("bucket-0", "bucket-1", "bucket-2", "bucket-3"),
+			acctest.ExpectListCallCount("ListBuckets", 3),
+			func(t *testing.T, results acctest.ListResults) {
+				t.Helper()
+
+				// Each page is requested with the previous page's continuation token, in the provider's Region.
+				var tokens []string
+				for _, call := range results.Calls {
+					if call.Operation != "ListBuckets" {
+						continue
+					}
+
+					query := call.URL.Query()
+					tokens = append(tokens, query.Get("continuation-token"))
+
+					if got, expected := query.Get("bucket-region"), "us-west-2"; got != expected { //lintignore:AWSAT003
+						t.Errorf("expected bucket-region %q on every page, got %q", expected, got)
+					}
+				}
+				if expected := []string{"", "1", "2"}; !slices.Equal(tokens, expected) {
+					t.Errorf("expected continuation tokens %q, got %q", expected, tokens)
+				}
+			},
+		},
+	})
+}
+
+func TestListBuckets_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	conn := &mockListBucketsClient{
+		pages: [][]string{
+			{"bucket-0"},
+			{"bucket-1"},
+		},
+	}
+
+	var got []string
+	var err error
+	for bucket, e := range tfs3.ListBuckets(ctx, conn, &s3.ListBucketsInput{}) {
+		if e != nil {
+			err = e
+			break
+		}
+
+		got = append(got, aws.ToString(bucket.Name))
+		// Listing is canceled once the first bucket is listed.
+		cancel()
+	}
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %s, got %v", context.Canceled, err)
+	}
+	if expected := []string{"bucket-0"}; !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if conn.calls != 1 {
+		t.Errorf("expected 1 ListBuckets call, got %d", conn.calls)
+	}
+}
+
+func TestListBuckets_resumable(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	conn := &mockListBucketsClient{
+		pages: [][]string{
+			{"bucket-0"},
+			{"bucket-1"},
+			{"bucket-2"},
+		},
+	}
+	input := s3.ListBucketsInput{
+		ContinuationToken: aws.String("1"),
+	}
+
+	var got []string
+	var err error
+	for bucket, e := range tfs3.ListBuckets(ctx, conn, &input) {
+		if e != nil {
+			err = e
+			break
+		}
+
+		got = append(got, aws.ToString(bucket.Name))
+		// Listing is canceled once the first bucket is listed.
+		cancel()
+	}
+
+	if expected := []string{"bucket-1"}; !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	// Listing can be resumed from the page that wasn't listed.
+	tokenErr, ok := errs.As[*framework.StartTokenError](err)
+	if !ok {
+		t.Fatalf("expected *framework.StartTokenError, got %v", err)
+	}
+	if got, expected := tokenErr.StartToken, "2"; got != expected {
+		t.Errorf("expected StartToken %q, got %q", expected, got)
+	}
+}
+
+func TestNewListBucketsInput(t *testing.T) {
+	t.Parallel()
+
+	const region = "us-west-2" //lintignore:AWSAT003
+
+	testCases := map[string]struct {
+		limit              int64
+		expectedMaxBuckets *int32
+	}{
+		"no limit": {
+			limit:              0,
+			expectedMaxBuckets: nil,
+		},
+		"limit": {
+			limit:              10,
+			expectedMaxBuckets: aws.Int32(10),
+		},
+		"limit at maximum": {
+			limit:              10000,
+			expectedMaxBuckets: aws.Int32(10000),
+		},
+		"limit above maximum": {
+			limit:              10001,
+			expectedMaxBuckets: aws.Int32(10000),
+		},
+		"limit beyond int32 range": {
+			limit:              math.MaxInt32 + 1,
+			expectedMaxBuckets: aws.Int32(10000),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			input := tfs3.NewListBucketsInput(region, testCase.limit)
+
+			if got := aws.ToString(input.BucketRegion); got != region {
+				t.Errorf("expected BucketRegion %q, got %q", region, got)
+			}
+
+			switch got, expected := input.MaxBuckets, testCase.expectedMaxBuckets; {
+			case expected == nil && got != nil:
+				t.Errorf("expected MaxBuckets to be unset, got %d", aws.ToInt32(got))
+			case expected != nil && got == nil:
+				t.Errorf("expected MaxBuckets %d, got unset", aws.ToInt32(expected))
+			case expected != nil && aws.ToInt32(got) != aws.ToInt32(expected):
+				t.Errorf("expected MaxBuckets %d, got %d", aws.ToInt32(expected), aws.ToInt32(got))
+			}
+		})
+	}
+}
+
+func TestNewListBucketsInput_allRegions(t *testing.T) {
+	t.Parallel()
+
+	input := tfs3.NewListBucketsInput("", 0)
+
+	if input.BucketRegion != nil {
+		t.Errorf("expected BucketRegion to be unset, got %q", aws.ToString(input.BucketRegion))
+	}
+}
+
+func TestListBucketsRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		region         string
+		bucketRegion   string
+		allRegions     bool
+		expectedRegion string
+		expectError    bool
+	}{
+		"region": {
+			region:         "us-west-2", //lintignore:AWSAT003
+			expectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		"empty region": {
+			expectError: true,
+		},
+		"bucket region": {
+			region:         "us-west-2", //lintignore:AWSAT003
+			bucketRegion:   "eu-west-1", //lintignore:AWSAT003
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"bucket region, empty region": {
+			bucketRegion:   "eu-west-1", //lintignore:AWSAT003
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"all regions": {
+			region:     "us-west-2", //lintignore:AWSAT003
+			allRegions: true,
+		},
+		"all regions, empty region": {
+			allRegions: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tfs3.ListBucketsRegion(testCase.region, testCase.bucketRegion, testCase.allRegions)
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Fatalf("expected error %t, got %t: %v", want, got, diags)
+			}
+			if testCase.expectError {
+				if got, want := diags[0].Detail(), "provider region is required for listing S3 buckets"; !strings.HasPrefix(got, want) {
+					t.Errorf("expected diagnostic detail starting %q, got %q", want, got)
+				}
+				return
+			}
+
+			if got != testCase.expectedRegion {
+				t.Errorf("expected Region %q, got %q", testCase.expectedRegion, got)
+			}
+		})
+	}
+}
+
+func TestIsPublicAccessBlockMissing(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		conf     *awstypes.PublicAccessBlockConfiguration
+		expected bool
+	}{
+		"no configuration": {
+			expected: true,
+		},
+		"empty configuration": {
+			conf:     &awstypes.PublicAccessBlockConfiguration{},
+			expected: true,
+		},
+		"all false": {
+			conf: &awstypes.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(false),
+				BlockPublicPolicy:     aws.Bool(false),
+				IgnorePublicAcls:      aws.Bool(false),
+				RestrictPublicBuckets: aws.Bool(false),
+			},
+			expected: true,
+		},
+		"one true": {
+			conf: &awstypes.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(false),
+				BlockPublicPolicy:     aws.Bool(false),
+				IgnorePublicAcls:      aws.Bool(false),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+			expected: false,
+		},
+		"all true": {
+			conf: &awstypes.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(true),
+				BlockPublicPolicy:     aws.Bool(true),
+				IgnorePublicAcls:      aws.Bool(true),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfs3.IsPublicAccessBlockMissing(testCase.conf); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestListTaggedBuckets(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	conn := &mockGetResourcesClient{
+		arns: []string{
+			"arn:aws:s3:::bucket-1", //lintignore:AWSAT005
+			"arn:aws:s3:::bucket-0", //lintignore:AWSAT005
+			"arn:aws:s3:us-west-2:123456789012:accesspoint/access-point", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	var got []string
+	for bucket, err := range tfs3.ListTaggedBuckets(ctx, conn, ratelimit.Scope{AccountID: "123456789012", Region: "us-west-2"}, map[string]string{"env": "prod"}) { //lintignore:AWSAT003
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, expected := aws.ToString(bucket.BucketRegion), "us-west-2"; got != expected { //lintignore:AWSAT003
+			t.Errorf("expected BucketRegion %q, got %q", expected, got)
+		}
+
+		got = append(got, aws.ToString(bucket.Name))
+	}
+
+	if expected := []string{"bucket-0", "bucket-1"}; !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if len(conn.inputs) != 1 {
+		t.Fatalf("expected 1 GetResources call, got %d", len(conn.inputs))
+	}
+	input := conn.inputs[0]
+	if expected := []string{"s3"}; !slices.Equal(input.ResourceTypeFilters, expected) {
+		t.Errorf("expected ResourceTypeFilters %v, got %v", expected, input.ResourceTypeFilters)
+	}
+	if len(input.TagFilters) != 1 || aws.ToString(input.TagFilters[0].Key) != "env" || !slices.Equal(input.TagFilters[0].Values, []string{"prod"}) {
+		t.Errorf("unexpected TagFilters: %v", input.TagFilters)
+	}
+}
+
+// testListBucketsPage returns the body of a ListBuckets response listing the named buckets.
+func testListBucketsPage(continuationToken string, bucketNames ...string) string {
+	var b strings.Builder
+
+	b.WriteString(`<ListAllMyBucketsResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Buckets>`)
+	for _, name := range bucketNames {
+		fmt.Fprintf(&b, `<Bucket><BucketRegion>us-west-2</BucketRegion><CreationDate>2025-01-01T00:00:00.000Z</CreationDate><Name>%s</Name></Bucket>`, name) //lintignore:AWSAT003
+	}
+	b.WriteString(`</Buckets>`)
+	if continuationToken != "" {
+		fmt.Fprintf(&b, `<ContinuationToken>%s</ContinuationToken>`, continuationToken)
+	}
+	b.WriteString(`</ListAllMyBucketsResult>`)
+
+	return b.String()
+}
+
+type mockListBucketsClient struct {
+	pages [][]string
+	calls int
+}
+
+func (c *mockListBucketsClient) ListBuckets(_ context.Context, input *s3.ListBucketsInput, _ ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+	c.calls++
+
+	var page int
+	if v := aws.ToString(input.ContinuationToken); v != "" {
+		var err error
+		if page, err = strconv.Atoi(v); err != nil {
+			return nil, err
+		}
+	}
+
+	var output s3.ListBucketsOutput
+	for _, name := range c.pages[page] {
+		output.Buckets = append(output.Buckets, awstypes.Bucket{Name: aws.String(name)})
+	}
+	if next := page + 1; next < len(c.pages) {
+		output.ContinuationToken = aws.String(strconv.Itoa(next))
+	}
+
+	return &output, nil
+}
+
+// mockGetResourcesClient returns the specified ARNs, untagged, in a single page.
+type mockGetResourcesClient struct {
+	arns   []string
+	inputs []resourcegroupstaggingapi.GetResourcesInput
+}
+
+func (c *mockGetResourcesClient) GetResources(_ context.Context, input *resourcegroupstaggingapi.GetResourcesInput, _ ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	c.inputs = append(c.inputs, *input)
+
+	var output resourcegroupstaggingapi.GetResourcesOutput
+	for _, arn := range c.arns {
+		output.ResourceTagMappingList = append(output.ResourceTagMappingList, taggingtypes.ResourceTagMapping{ResourceARN: aws.String(arn)})
+	}
+
+	return &output, nil
+}
@@ -60,6 +60,11 @@ var (
 	FindServerSideEncryptionConfiguration       = findServerSideEncryptionConfiguration
 	HostedZoneIDForRegion                       = hostedZoneIDForRegion
 	IsDirectoryBucket                           = isDirectoryBucket
+	IsPublicAccessBlockMissing                  = isPublicAccessBlockMissing
+	ListBuckets                                 = listBuckets
+	ListBucketsRegion                           = listBucketsRegion
+	ListTaggedBuckets                           = listTaggedBuckets
+	NewListBucketsInput                         = newListBucketsInput
 	ObjectListTags                              = objectListTags
 	ObjectUpdateTags                            = objectUpdateTags
 	SDKv1CompatibleCleanKey                     = sdkv1CompatibleCleanKey
@@ -7,6 +7,7 @@ package sagemaker
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -31,16 +32,15 @@ import (
 
 // @SDKResource("aws_sagemaker_model", name="Model")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(idAttrDuplicates="name")
+// @Testing(preIdentityVersion="v6.34.0")
 func resourceModel() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceModelCreate,
 		ReadWithoutTimeout:   resourceModelRead,
 		UpdateWithoutTimeout: resourceModelUpdate,
 		DeleteWithoutTimeout: resourceModelDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -587,25 +587,8 @@ func resourceModelRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		return sdkdiag.AppendErrorf(diags, "reading SageMaker AI model %s: %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, output.ModelArn)
-	d.Set(names.AttrName, output.ModelName)
-	d.Set(names.AttrExecutionRoleARN, output.ExecutionRoleArn)
-	d.Set("enable_network_isolation", output.EnableNetworkIsolation)
-
-	if err := d.Set("primary_container", flattenContainer(output.PrimaryContainer)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting primary_container: %s", err)
-	}
-
-	if err := d.Set("container", flattenContainers(output.Containers)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting container: %s", err)
-	}
-
-	if err := d.Set(names.AttrVPCConfig, flattenVPCConfigResponse(output.VpcConfig)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
-	}
-
-	if err := d.Set("inference_execution_config", flattenModelInferenceExecutionConfig(output.InferenceExecutionConfig)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting inference_execution_config: %s", err)
+	if err := resourceModelFlatten(ctx, output, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
 	return diags
@@ -652,6 +635,31 @@ func resourceModelDelete(ctx context.Context, d *schema.ResourceData, meta any)
 	return diags
 }
 
+func resourceModelFlatten(_ context.Context, output *sagemaker.DescribeModelOutput, d *schema.ResourceData) error {
+	d.Set(names.AttrARN, output.ModelArn)
+	d.Set(names.AttrName, output.ModelName)
+	d.Set(names.AttrExecutionRoleARN, output.ExecutionRoleArn)
+	d.Set("enable_network_isolation", output.EnableNetworkIsolation)
+
+	if err := d.Set("primary_container", flattenContainer(output.PrimaryContainer)); err != nil {
+		return fmt.Errorf("setting primary_container: %w", err)
+	}
+
+	if err := d.Set("container", flattenContainers(output.Containers)); err != nil {
+		return fmt.Errorf("setting container: %w", err)
+	}
+
+	if err := d.Set(names.AttrVPCConfig, flattenVPCConfigResponse(output.VpcConfig)); err != nil {
+		return fmt.Errorf("setting vpc_config: %w", err)
+	}
+
+	if err := d.Set("inference_execution_config", flattenModelInferenceExecutionConfig(output.InferenceExecutionConfig)); err != nil {
+		return fmt.Errorf("setting inference_execution_config: %w", err)
+	}
+
+	return nil
+}
+
 func findModelByName(ctx context.Context, conn *sagemaker.Client, name string) (*sagemaker.DescribeModelOutput, error) {
 	input := &sagemaker.DescribeModelInput{
 		ModelName: aws.String(name),
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_sagemaker_model")
+func newModelResourceAsListResource() inttypes.ListResourceForSDK {
+	l := modelListResource{}
+	l.SetResourceSchema(resourceModel())
+
+	return &l
+}
+
+var _ list.ListResource = &modelListResource{}
+
+type modelListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type modelListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *modelListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.SageMakerClient(ctx)
+
+	var query modelListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing SageMaker AI Models")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		describeModelLimiter := describeModelRateLimiters.For(awsClient.RateLimitScope(ctx))
+		listTagsLimiter := listTagsRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input sagemaker.ListModelsInput
+		if request.Limit > 0 && request.Limit < listModelsMaxResults {
+			input.MaxResults = aws.Int32(int32(request.Limit))
+		}
+
+		var count int64
+		for page, err := range listModelPages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if request.Limit > 0 {
+				if remaining := request.Limit - count; int64(len(page)) > remaining {
+					page = page[:remaining]
+				}
+			}
+
+			var tags map[string]tftags.KeyValueTags
+			if request.IncludeResource {
+				arns := make([]string, 0, len(page))
+				for _, model := range page {
+					arns = append(arns, aws.ToString(model.ModelArn))
+				}
+
+				tags, err = findModelTags(ctx, conn, listTagsLimiter, arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for _, model := range page {
+				name := aws.ToString(model.ModelName)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), name)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(name)
+				rd.Set(names.AttrName, name)
+
+				if request.IncludeResource {
+					// Models aren't read once listing has been canceled.
+					if err := ctx.Err(); err != nil {
+						result := fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+
+					// ListModels doesn't return the containers or the execution role.
+					tflog.Info(ctx, "Reading SageMaker AI Model")
+					output, err := ratelimit.Call(ctx, describeModelLimiter, func(ctx context.Context) (*sagemaker.DescribeModelOutput, error) {
+						return findModelByName(ctx, conn, name)
+					})
+					if err != nil {
+						tflog.Error(ctx, "Reading SageMaker AI Model", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					if err := resourceModelFlatten(ctx, output, rd); err != nil {
+						tflog.Error(ctx, "Reading SageMaker AI Model", map[string]any{
+							"error": err.Error(),
+						})
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tags[aws.ToString(model.ModelArn)]))
+				}
+
+				result.DisplayName = name
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				count++
+				if !yield(result) {
+					return
+				}
+			}
+
+			if request.Limit > 0 && count >= request.Limit {
+				return
+			}
+		}
+	}
+}
+
+const (
+	// The maximum number of models that ListModels returns in a single page.
+	listModelsMaxResults = 100
+)
+
+func listModelPages(ctx context.Context, conn *sagemaker.Client, input *sagemaker.ListModelsInput) iter.Seq2[[]awstypes.ModelSummary, error] {
+	return func(yield func([]awstypes.ModelSummary, error) bool) {
+		pages := sagemaker.NewListModelsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing SageMaker AI Models: %w", err))
+				return
+			}
+
+			if !yield(page.Models, nil) {
+				return
+			}
+		}
+	}
+}
+
+// findModelTags returns the tags of each of the specified models, keyed by ARN.
+func findModelTags(ctx context.Context, conn *sagemaker.Client, limiter *ratelimit.Limiter, arns []string) (map[string]tftags.KeyValueTags, error) {
+	tags := make(map[string]tftags.KeyValueTags, len(arns))
+
+	for _, arn := range arns {
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, arn)
+		})
+		if errs.IsA[*awstypes.ResourceNotFound](err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for SageMaker AI Model (%s): %w", arn, err)
+		}
+
+		tags[arn] = v
+	}
+
+	return tags, nil
+}
+
+// describeModelRateLimiters and listTagsRateLimiters limit the calls made to hydrate listed models,
+// each to 10 per second by default.
+var (
+	describeModelRateLimiters = ratelimit.Register("sagemaker:DescribeModel", 10, 10)
+	listTagsRateLimiters      = ratelimit.Register("sagemaker:ListTags", 10, 10)
+)
@@ -16,4 +16,7 @@ var (
 	FindSecretVersionByTwoPartKey      = findSecretVersionByTwoPartKey
 	FindSecretVersionEntryByTwoPartKey = findSecretVersionEntryByTwoPartKey
 	FindSecretTag                      = findSecretTag
+	SecretFailedReplicaRegions         = secretFailedReplicaRegions
+	SecretReplicaStatusSummary         = secretReplicaStatusSummary
+	SecretRotationEnabledFilter        = secretRotationEnabledFilter
 )
@@ -7,16 +7,23 @@ import (
 	"context"
 	"fmt"
 	"iter"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -34,6 +41,19 @@ type listResourceSecret struct {
 	framework.ListResourceWithSDKv2Resource
 }
 
+func (l *listResourceSecret) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"include_replication": listschema.BoolAttribute{
+				Optional: true,
+			},
+			"rotation_enabled": listschema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
 func (l *listResourceSecret) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
 	conn := l.Meta().SecretsManagerClient(ctx)
 
@@ -45,6 +65,8 @@ func (l *listResourceSecret) List(ctx context.Context, request list.ListRequest,
 		}
 	}
 
+	filter := secretRotationEnabledFilter(query.RotationEnabled)
+
 	tflog.Info(ctx, "Listing Secrets Manager Secret")
 	stream.Results = func(yield func(list.ListResult) bool) {
 		var input secretsmanager.ListSecretsInput
@@ -55,6 +77,10 @@ func (l *listResourceSecret) List(ctx context.Context, request list.ListRequest,
 				return
 			}
 
+			if !filter(&item) {
+				continue
+			}
+
 			arn := aws.ToString(item.ARN)
 			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
 
@@ -78,6 +104,22 @@ func (l *listResourceSecret) List(ctx context.Context, request list.ListRequest,
 
 			result.DisplayName = aws.ToString(item.Name)
 
+			// Replication status is already read via DescribeSecret into the replica attribute, no additional calls are needed.
+			// When the resource is included the status is in its replica blocks, otherwise it is appended to the display name.
+			if query.IncludeReplication.ValueBool() {
+				replicas := rd.Get("replica").(*schema.Set).List()
+				if summary := secretReplicaStatusSummary(replicas); summary != "" && !request.IncludeResource {
+					result.DisplayName = fmt.Sprintf("%s (replicas: %s)", result.DisplayName, summary)
+				}
+
+				if failed := secretFailedReplicaRegions(replicas); len(failed) > 0 {
+					result.Diagnostics.Append(diag.NewWarningDiagnostic(
+						"Secrets Manager Secret Replication Failed",
+						fmt.Sprintf("Replication of Secrets Manager Secret (%s) failed in Region(s): %s", arn, strings.Join(failed, ", ")),
+					))
+				}
+			}
+
 			l.SetResult(ctx, l.Meta(), request.IncludeResource, &result, rd)
 			if result.Diagnostics.HasError() {
 				yield(result)
@@ -93,6 +135,62 @@ func (l *listResourceSecret) List(ctx context.Context, request list.ListRequest,
 
 type listSecretModel struct {
 	framework.WithRegionModel
+	IncludeReplication types.Bool `tfsdk:"include_replication"`
+	RotationEnabled    types.Bool `tfsdk:"rotation_enabled"`
+}
+
+// secretRotationEnabledFilter returns a predicate selecting secrets whose rotation status matches rotationEnabled.
+// ListSecrets has no server-side filter on rotation, so it is applied client-side. A null value selects all secrets.
+func secretRotationEnabledFilter(rotationEnabled types.Bool) tfslices.Predicate[*awstypes.SecretListEntry] {
+	if rotationEnabled.IsNull() || rotationEnabled.IsUnknown() {
+		return tfslices.PredicateTrue[*awstypes.SecretListEntry]()
+	}
+
+	want := rotationEnabled.ValueBool()
+	return func(v *awstypes.SecretListEntry) bool {
+		return aws.ToBool(v.RotationEnabled) == want
+	}
+}
+
+// secretFailedReplicaRegions returns the sorted Regions of the replicas whose replication status is Failed.
+func secretFailedReplicaRegions(tfList []any) []string {
+	var failed []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if status, _ := tfMap[names.AttrStatus].(string); status == string(awstypes.StatusTypeFailed) {
+			region, _ := tfMap[names.AttrRegion].(string)
+			failed = append(failed, region)
+		}
+	}
+
+	slices.Sort(failed)
+
+	return failed
+}
+
+// secretReplicaStatusSummary returns the Region and replication status of each replica, sorted by Region.
+func secretReplicaStatusSummary(tfList []any) string {
+	var replicas []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		region, _ := tfMap[names.AttrRegion].(string)
+		status, _ := tfMap[names.AttrStatus].(string)
+		replicas = append(replicas, region+" "+status)
+	}
+
+	slices.Sort(replicas)
+
+	return strings.Join(replicas, ", ")
 }
 
 func listSecrets(ctx context.Context, conn *secretsmanager.Client, input *secretsmanager.ListSecretsInput) iter.Seq2[awstypes.SecretListEntry, error] {
@@ -5,9 +5,13 @@ package secretsmanager_test
 
 import (
 	"regexp"
+	"slices"
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -18,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
 	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -79,3 +84,98 @@ func TestAccSecretsManagerSecret_List_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestSecretFailedReplicaRegions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input           []any
+		expectedFailed  []string
+		expectedSummary string
+	}{
+		"no replicas": {},
+		"replicas in sync": {
+			input: []any{
+				map[string]any{
+					names.AttrRegion: "us-west-2", //lintignore:AWSAT003
+					names.AttrStatus: "InSync",
+				},
+				map[string]any{
+					names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+					names.AttrStatus: "InProgress",
+				},
+			},
+			expectedSummary: "eu-west-1 InProgress, us-west-2 InSync", //lintignore:AWSAT003
+		},
+		"replica failed": {
+			input: []any{
+				map[string]any{
+					names.AttrRegion: "us-west-2", //lintignore:AWSAT003
+					names.AttrStatus: "InSync",
+				},
+				map[string]any{
+					names.AttrRegion:        "eu-west-1", //lintignore:AWSAT003
+					names.AttrStatus:        "Failed",
+					names.AttrStatusMessage: "Replication failed: a secret with this name already exists in this region",
+				},
+			},
+			expectedFailed:  []string{"eu-west-1"},                //lintignore:AWSAT003
+			expectedSummary: "eu-west-1 Failed, us-west-2 InSync", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			failed := tfsecretsmanager.SecretFailedReplicaRegions(testCase.input)
+
+			if !slices.Equal(failed, testCase.expectedFailed) {
+				t.Errorf("expected failed regions %v, got %v", testCase.expectedFailed, failed)
+			}
+
+			if summary := tfsecretsmanager.SecretReplicaStatusSummary(testCase.input); summary != testCase.expectedSummary {
+				t.Errorf("expected summary %q, got %q", testCase.expectedSummary, summary)
+			}
+		})
+	}
+}
+
+func TestSecretRotationEnabledFilter(t *testing.T) {
+	t.Parallel()
+
+	rotated := &awstypes.SecretListEntry{RotationEnabled: aws.Bool(true)}
+	notRotated := &awstypes.SecretListEntry{RotationEnabled: aws.Bool(false)}
+	neverRotated := &awstypes.SecretListEntry{}
+
+	testCases := map[string]struct {
+		rotationEnabled types.Bool
+		expected        []bool
+	}{
+		"null": {
+			rotationEnabled: types.BoolNull(),
+			expected:        []bool{true, true, true},
+		},
+		"true": {
+			rotationEnabled: types.BoolValue(true),
+			expected:        []bool{true, false, false},
+		},
+		"false": {
+			rotationEnabled: types.BoolValue(false),
+			expected:        []bool{false, true, true},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := tfsecretsmanager.SecretRotationEnabledFilter(testCase.rotationEnabled)
+
+			got := []bool{filter(rotated), filter(notRotated), filter(neverRotated)}
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
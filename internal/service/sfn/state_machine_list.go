@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_sfn_state_machine")
+func newStateMachineResourceAsListResource() inttypes.ListResourceForSDK {
+	l := stateMachineListResource{}
+	l.SetResourceSchema(resourceStateMachine())
+
+	return &l
+}
+
+var _ list.ListResource = &stateMachineListResource{}
+
+type stateMachineListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type stateMachineListResourceModel struct {
+	framework.WithRegionModel
+	Type types.String `tfsdk:"type"`
+}
+
+func (l *stateMachineListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrType: listschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Values[awstypes.StateMachineType]()...),
+				},
+			},
+		},
+	}
+}
+
+func (l *stateMachineListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.SFNClient(ctx)
+
+	var query stateMachineListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	filter := stateMachineTypeFilter(query.Type)
+
+	tflog.Info(ctx, "Listing Step Functions State Machines")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := describeStateMachineRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input sfn.ListStateMachinesInput
+		for page, err := range listStateMachinePages(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			page = tfslices.Filter(page, func(v awstypes.StateMachineListItem) bool {
+				return filter(&v)
+			})
+
+			// Tags are fetched for the whole page in as few Resource Groups Tagging API calls as possible
+			// rather than with one ListTagsForResource call per state machine.
+			var tags map[string]map[string]string
+			if request.IncludeResource {
+				arns := make([]string, 0, len(page))
+				for _, stateMachine := range page {
+					arns = append(arns, aws.ToString(stateMachine.StateMachineArn))
+				}
+
+				tags, err = tftags.BatchFetchResourceTags(ctx, awsClient.ResourceGroupsTaggingAPIClient(ctx), awsClient.RateLimitScope(ctx), arns)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing Step Functions State Machine tags: %w", err))
+					yield(result)
+					return
+				}
+			}
+
+			for _, stateMachine := range page {
+				arn, name := aws.ToString(stateMachine.StateMachineArn), aws.ToString(stateMachine.Name)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(arn)
+				rd.Set(names.AttrARN, arn)
+				rd.Set(names.AttrName, name)
+
+				if request.IncludeResource {
+					if err := limiter.Wait(ctx); err != nil {
+						result := fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+
+					tflog.Info(ctx, "Reading Step Functions State Machine")
+					diags := resourceStateMachineRead(ctx, rd, awsClient)
+					if diags.HasError() {
+						tflog.Error(ctx, "Reading Step Functions State Machine", map[string]any{
+							"diags": sdkdiag.DiagnosticsString(diags),
+						})
+						continue
+					}
+					if rd.Id() == "" {
+						// Resource is logically deleted
+						continue
+					}
+
+					setTagsOut(ctx, svcTags(tftags.New(ctx, tags[arn])))
+				}
+
+				result.DisplayName = name
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// stateMachineTypeFilter returns a predicate selecting state machines of the specified type.
+// A null value selects all state machines.
+func stateMachineTypeFilter(typ types.String) tfslices.Predicate[*awstypes.StateMachineListItem] {
+	if typ.IsNull() || typ.IsUnknown() {
+		return tfslices.PredicateTrue[*awstypes.StateMachineListItem]()
+	}
+
+	stateMachineType := awstypes.StateMachineType(typ.ValueString())
+	return func(v *awstypes.StateMachineListItem) bool {
+		return v.Type == stateMachineType
+	}
+}
+
+func listStateMachinePages(ctx context.Context, conn *sfn.Client, input *sfn.ListStateMachinesInput) iter.Seq2[[]awstypes.StateMachineListItem, error] {
+	return func(yield func([]awstypes.StateMachineListItem, error) bool) {
+		pages := sfn.NewListStateMachinesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(nil, fmt.Errorf("listing Step Functions State Machines: %w", err))
+				return
+			}
+
+			if !yield(page.StateMachines, nil) {
+				return
+			}
+		}
+	}
+}
+
+// describeStateMachineRateLimiters limit the DescribeStateMachine calls made to hydrate listed state machines.
+// DescribeStateMachine shares a low per-account token bucket with the other Step Functions control plane APIs,
+// so state machines are hydrated at no more than 10 per second by default.
+var describeStateMachineRateLimiters = ratelimit.Register("states:DescribeStateMachine", 10, 10)
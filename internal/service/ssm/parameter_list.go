@@ -12,10 +12,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,6 +37,17 @@ type parameterListResource struct {
 
 type parameterListResourceModel struct {
 	framework.WithRegionModel
+	PathPrefix types.String `tfsdk:"path_prefix"`
+}
+
+func (l *parameterListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"path_prefix": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
 }
 
 func (l *parameterListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
@@ -48,67 +63,118 @@ func (l *parameterListResource) List(ctx context.Context, request list.ListReque
 	}
 
 	var input ssm.DescribeParametersInput
+	if v := query.PathPrefix.ValueString(); v != "" {
+		input.ParameterFilters = []awstypes.ParameterStringFilter{
+			{
+				Key:    aws.String("Path"),
+				Option: aws.String("Recursive"),
+				Values: []string{v},
+			},
+		}
+	}
 
 	tflog.Info(ctx, "Listing SSM parameters")
 
 	stream.Results = func(yield func(list.ListResult) bool) {
-		for parameter, err := range listParameters(ctx, conn, &input) {
+		limiter := listTagsForResourceRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		for page, err := range listParameterPages(ctx, conn, &input) {
 			if err != nil {
 				result := fwdiag.NewListResultErrorDiagnostic(err)
 				yield(result)
 				return
 			}
 
-			name := aws.ToString(parameter.Name)
-			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), name)
-
-			result := request.NewListResult(ctx)
-
-			rd := l.ResourceData()
-			rd.SetId(name)
-
-			tflog.Info(ctx, "Reading SSM parameter")
-			diags := resourceParameterRead(ctx, rd, awsClient)
-			if diags.HasError() {
-				result = fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading SSM parameter %s", name))
-				yield(result)
-				return
-			}
-			if rd.Id() == "" {
-				// Resource is logically deleted
-				continue
+			var tags map[string][]awstypes.Tag
+			if request.IncludeResource {
+				tags, err = findParameterTags(ctx, conn, limiter, page)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
 			}
 
-			result.DisplayName = name
+			for _, parameter := range page {
+				name := aws.ToString(parameter.Name)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), name)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(name)
+				rd.Set(names.AttrName, name)
+
+				// DescribeParameters never returns parameter values, so they are only read when the full resource is requested.
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading SSM parameter")
+					diags := resourceParameterRead(ctx, rd, awsClient)
+					if diags.HasError() {
+						result = fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading SSM parameter %s", name))
+						yield(result)
+						return
+					}
+					if rd.Id() == "" {
+						// Resource is logically deleted
+						continue
+					}
+
+					setTagsOut(ctx, tags[aws.ToString(parameter.ARN)])
+				}
 
-			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
-			if result.Diagnostics.HasError() {
-				yield(result)
-				return
-			}
+				result.DisplayName = name
 
-			if !yield(result) {
-				return
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
 			}
 		}
 	}
 }
 
-func listParameters(ctx context.Context, conn *ssm.Client, input *ssm.DescribeParametersInput) iter.Seq2[awstypes.ParameterMetadata, error] {
-	return func(yield func(awstypes.ParameterMetadata, error) bool) {
+func listParameterPages(ctx context.Context, conn *ssm.Client, input *ssm.DescribeParametersInput) iter.Seq2[[]awstypes.ParameterMetadata, error] {
+	return func(yield func([]awstypes.ParameterMetadata, error) bool) {
 		pages := ssm.NewDescribeParametersPaginator(conn, input)
 		for pages.HasMorePages() {
 			page, err := pages.NextPage(ctx)
 			if err != nil {
-				yield(awstypes.ParameterMetadata{}, fmt.Errorf("listing SSM Parameters: %w", err))
+				yield(nil, fmt.Errorf("listing SSM Parameters: %w", err))
 				return
 			}
 
-			for _, parameter := range page.Parameters {
-				if !yield(parameter, nil) {
-					return
-				}
+			if !yield(page.Parameters, nil) {
+				return
 			}
 		}
 	}
 }
+
+// findParameterTags returns the tags of the specified parameters, keyed by ARN.
+// SSM has no batch tagging API, so ListTagsForResource is called once per parameter.
+func findParameterTags(ctx context.Context, conn *ssm.Client, limiter *ratelimit.Limiter, parameters []awstypes.ParameterMetadata) (map[string][]awstypes.Tag, error) {
+	tags := make(map[string][]awstypes.Tag, len(parameters))
+
+	for _, parameter := range parameters {
+		name := aws.ToString(parameter.Name)
+		v, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (tftags.KeyValueTags, error) {
+			return listTags(ctx, conn, name, string(awstypes.ResourceTypeForTaggingParameter))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for SSM Parameter (%s): %w", name, err)
+		}
+
+		tags[aws.ToString(parameter.ARN)] = svcTags(v)
+	}
+
+	return tags, nil
+}
+
+// listTagsForResourceRateLimiters limit the ListTagsForResource calls made to hydrate listed parameters.
+// ListTagsForResource is throttled at a low rate, so parameter tags are listed at no more than 10 per second by default.
+var listTagsForResourceRateLimiters = ratelimit.Register("ssm:ListTagsForResource", 10, 10)
@@ -35,6 +35,12 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 
 // @SDKResource("aws_transfer_server", name="Server")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/transfer/types;awstypes;awstypes.DescribedServer")
+// @Testing(importIgnore="force_destroy")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="v6.34.0")
+// @Testing(serialize=true)
 func resourceServer() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceServerCreate,
@@ -42,10 +48,6 @@ func resourceServer() *schema.Resource {
 		UpdateWithoutTimeout: resourceServerUpdate,
 		DeleteWithoutTimeout: resourceServerDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		CustomizeDiff: customdiff.Sequence(
 			customdiff.ForceNewIfChange("endpoint_details.0.vpc_id", func(_ context.Context, old, new, meta any) bool {
 				// "InvalidRequestException: Changing VpcId is not supported".
@@ -487,6 +489,15 @@ func resourceServerRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		return sdkdiag.AppendErrorf(diags, "reading Transfer Server (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceServerFlatten(ctx, meta.(*conns.AWSClient), output, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceServerFlatten(ctx context.Context, awsClient *conns.AWSClient, output *awstypes.DescribedServer, d *schema.ResourceData) error {
+	serverID := aws.ToString(output.ServerId)
 	d.Set(names.AttrARN, output.Arn)
 	d.Set(names.AttrCertificate, output.Certificate)
 	if output.IdentityProviderDetails != nil {
@@ -495,17 +506,17 @@ func resourceServerRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		d.Set("directory_id", "")
 	}
 	d.Set(names.AttrDomain, output.Domain)
-	d.Set(names.AttrEndpoint, meta.(*conns.AWSClient).RegionalHostname(ctx, fmt.Sprintf("%s.server.transfer", d.Id())))
+	d.Set(names.AttrEndpoint, awsClient.RegionalHostname(ctx, fmt.Sprintf("%s.server.transfer", serverID)))
 	if output.EndpointDetails != nil {
 		securityGroupIDs := make([]*string, 0)
 
 		// Security Group IDs are not returned for VPC endpoints.
 		if output.EndpointType == awstypes.EndpointTypeVpc && len(output.EndpointDetails.SecurityGroupIds) == 0 {
 			vpcEndpointID := aws.ToString(output.EndpointDetails.VpcEndpointId)
-			output, err := tfec2.FindVPCEndpointByID(ctx, meta.(*conns.AWSClient).EC2Client(ctx), vpcEndpointID)
+			output, err := tfec2.FindVPCEndpointByID(ctx, awsClient.EC2Client(ctx), vpcEndpointID)
 
 			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "reading Transfer Server (%s) VPC Endpoint (%s): %s", d.Id(), vpcEndpointID, err)
+				return fmt.Errorf("reading Transfer Server (%s) VPC Endpoint (%s): %w", serverID, vpcEndpointID, err)
 			}
 
 			for _, group := range output.Groups {
@@ -514,7 +525,7 @@ func resourceServerRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		}
 
 		if err := d.Set("endpoint_details", []any{flattenEndpointDetails(output.EndpointDetails, securityGroupIDs)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting endpoint_details: %s", err)
+			return fmt.Errorf("setting endpoint_details: %w", err)
 		}
 	} else {
 		d.Set("endpoint_details", nil)
@@ -541,11 +552,11 @@ func resourceServerRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	d.Set("post_authentication_login_banner", output.PostAuthenticationLoginBanner)
 	d.Set("pre_authentication_login_banner", output.PreAuthenticationLoginBanner)
 	if err := d.Set("protocol_details", flattenProtocolDetails(output.ProtocolDetails)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting protocol_details: %s", err)
+		return fmt.Errorf("setting protocol_details: %w", err)
 	}
 	d.Set("protocols", output.Protocols)
 	if err := d.Set("s3_storage_options", flattenS3StorageOptions(output.S3StorageOptions)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting s3_storage_options: %s", err)
+		return fmt.Errorf("setting s3_storage_options: %w", err)
 	}
 	d.Set("security_policy_name", output.SecurityPolicyName)
 	d.Set("structured_log_destinations", output.StructuredLogDestinations)
@@ -555,12 +566,12 @@ func resourceServerRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		d.Set(names.AttrURL, "")
 	}
 	if err := d.Set("workflow_details", flattenWorkflowDetails(output.WorkflowDetails)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting workflow_details: %s", err)
+		return fmt.Errorf("setting workflow_details: %w", err)
 	}
 
 	setTagsOut(ctx, output.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_transfer_server")
+func newServerResourceAsListResource() inttypes.ListResourceForSDK {
+	l := serverListResource{}
+	l.SetResourceSchema(resourceServer())
+
+	return &l
+}
+
+var _ list.ListResource = &serverListResource{}
+
+type serverListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type serverListResourceModel struct {
+	framework.WithRegionModel
+	Protocol types.String `tfsdk:"protocol"`
+}
+
+func (l *serverListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrProtocol: listschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Values[awstypes.Protocol]()...),
+				},
+			},
+		},
+	}
+}
+
+func (l *serverListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.TransferClient(ctx)
+
+	var query serverListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	filter := serverProtocolFilter(query.Protocol)
+
+	tflog.Info(ctx, "Listing Transfer Servers")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		limiter := describeServerRateLimiters.For(awsClient.RateLimitScope(ctx))
+
+		var input transfer.ListServersInput
+		for item, err := range listServers(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			serverID := aws.ToString(item.ServerId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), serverID)
+
+			// ListServers does not return protocols, so each server is described before filtering.
+			server, err := ratelimit.Call(ctx, limiter, func(ctx context.Context) (*awstypes.DescribedServer, error) {
+				return findServerByID(ctx, conn, serverID)
+			})
+			if retry.NotFound(err) {
+				continue
+			}
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading Transfer Server (%s): %w", serverID, err))
+				yield(result)
+				return
+			}
+
+			if !filter(server) {
+				continue
+			}
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(serverID)
+
+			if request.IncludeResource {
+				tflog.Info(ctx, "Reading Transfer Server")
+				if err := resourceServerFlatten(ctx, awsClient, server, rd); err != nil {
+					tflog.Error(ctx, "Reading Transfer Server", map[string]any{
+						"error": err.Error(),
+					})
+					continue
+				}
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%s)", serverID, server.EndpointType)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// serverProtocolFilter returns a predicate selecting servers that support the specified protocol.
+// A null value selects all servers.
+func serverProtocolFilter(protocol types.String) tfslices.Predicate[*awstypes.DescribedServer] {
+	if protocol.IsNull() || protocol.IsUnknown() {
+		return tfslices.PredicateTrue[*awstypes.DescribedServer]()
+	}
+
+	p := awstypes.Protocol(protocol.ValueString())
+	return func(v *awstypes.DescribedServer) bool {
+		return slices.Contains(v.Protocols, p)
+	}
+}
+
+func listServers(ctx context.Context, conn *transfer.Client, input *transfer.ListServersInput) iter.Seq2[awstypes.ListedServer, error] {
+	return func(yield func(awstypes.ListedServer, error) bool) {
+		pages := transfer.NewListServersPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.ListedServer{}, fmt.Errorf("listing Transfer Servers: %w", err))
+				return
+			}
+
+			for _, server := range page.Servers {
+				if !yield(server, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// describeServerRateLimiters limit the DescribeServer calls made to filter and hydrate listed servers, to 10 per second by default.
+var describeServerRateLimiters = ratelimit.Register("transfer:DescribeServer", 10, 10)
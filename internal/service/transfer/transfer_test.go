@@ -47,7 +47,9 @@ func TestAccTransfer_serial(t *testing.T) {
 			"Domain":                          testAccServer_domain,
 			"ForceDestroy":                    testAccServer_forceDestroy,
 			"HostKey":                         testAccServer_hostKey,
+			"Identity":                        testAccTransferServer_identitySerial,
 			"LambdaFunction":                  testAccServer_lambdaFunction,
+			"List":                            testAccTransferServer_listSerial,
 			"Protocols":                       testAccServer_protocols,
 			"ProtocolDetails":                 testAccServer_protocolDetails,
 			"S3StorageOptions":                testAccServer_s3StorageOptions,
@@ -0,0 +1,270 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+)
+
+const (
+	// The maximum number of ARNs that can be passed in a single GetResources call.
+	batchFetchResourceTagsChunkSize = 100
+
+	// The maximum number of chunks whose tags are fetched concurrently.
+	// GetResources calls are rate limited regardless, so this only bounds the number of calls in flight.
+	batchFetchResourceTagsConcurrency = 4
+)
+
+// BatchFetchResourceTags returns the tags of the specified resources, keyed by ARN, using the Resource Groups Tagging API.
+// ARNs are requested in chunks of at most 100, several chunks at a time, and each GetResources call is rate limited
+// in the specified scope, which is that of the account and Region in which conn makes calls.
+// Tags fetched within the last minute, by any list resource, are reused rather than requested again,
+// as is the absence of resources that GetResources didn't return.
+// The result is keyed by the requested ARNs, even if GetResources returns them with a different case or partition.
+// Resources without tags may be absent from the result.
+func BatchFetchResourceTags(ctx context.Context, conn resourcegroupstaggingapi.GetResourcesAPIClient, scope ratelimit.Scope, arns []string) (map[string]map[string]string, error) {
+	limiter := getResourcesRateLimiters.For(scope)
+	return batchFetchResourceTags(ctx, conn, batchTagCache, arns, limiter.Wait, observeThrottling(limiter))
+}
+
+// batchFetchResourceTags is BatchFetchResourceTags with the specified cache, and wait called before each GetResources call.
+func batchFetchResourceTags(ctx context.Context, conn resourcegroupstaggingapi.GetResourcesAPIClient, cache *tagCache, arns []string, wait func(context.Context) error, optFns ...func(*resourcegroupstaggingapi.Options)) (map[string]map[string]string, error) {
+	tags := make(map[string]map[string]string, len(arns))
+
+	arns = slices.DeleteFunc(slices.Clone(arns), func(arn string) bool {
+		v, ok := cache.get(arn)
+		if ok {
+			tags[arn] = v
+		}
+		return ok
+	})
+
+	// ResourceARNList can't be combined with ResourceTypeFilters or TagFilters.
+	fetchChunk := func(ctx context.Context, chunk []string) batchFetchResult {
+		// Chunks aren't fetched once listing has been canceled.
+		if err := ctx.Err(); err != nil {
+			return batchFetchResult{err: err}
+		}
+
+		result := batchFetchResult{
+			tags: make(map[string]map[string]string, len(chunk)),
+		}
+		input := resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: chunk,
+		}
+		for page, err := range getResourcesPages(ctx, conn, &input, wait, optFns...) {
+			if err != nil {
+				result.err = err
+				break
+			}
+
+			maps.Copy(result.tags, page)
+		}
+		if result.err == nil {
+			result.tags = matchRequestedARNs(ctx, chunk, result.tags)
+
+			for _, arn := range chunk {
+				if _, ok := result.tags[arn]; !ok {
+					result.missing = append(result.missing, arn)
+				}
+			}
+		}
+		return result
+	}
+
+	// Results are merged in chunk order, whatever order the chunks complete in.
+	chunks := func(context.Context) iter.Seq[[]string] {
+		return slices.Chunk(arns, batchFetchResourceTagsChunkSize)
+	}
+	for result := range tfiter.MappedConcurrently(ctx, chunks, batchFetchResourceTagsConcurrency, fetchChunk) {
+		if result.err != nil {
+			return nil, fmt.Errorf("reading resource tags: %w", result.err)
+		}
+
+		maps.Copy(tags, result.tags)
+		cache.put(result.tags)
+
+		// Resources that GetResources doesn't return, such as untagged resources, are cached as having no tags,
+		// so that they aren't requested again either.
+		missing := make(map[string]map[string]string, len(result.missing))
+		for _, arn := range result.missing {
+			missing[arn] = map[string]string{}
+		}
+		cache.put(missing)
+	}
+
+	return tags, nil
+}
+
+// matchRequestedARNs returns tags, keyed by ARN as returned by GetResources, re-keyed by the requested ARNs.
+// ARNs that differ from the requested ARNs only in case or partition are matched to them, unless that is ambiguous,
+// and ARNs that match no requested ARN are dropped.
+func matchRequestedARNs(ctx context.Context, requested []string, tags map[string]map[string]string) map[string]map[string]string {
+	byNormalizedARN := make(map[string]string, len(requested))
+	ambiguous := make(map[string]bool)
+	for _, v := range requested {
+		k := normalizeARN(v)
+		if _, ok := byNormalizedARN[k]; ok {
+			ambiguous[k] = true
+		}
+		byNormalizedARN[k] = v
+	}
+
+	matched := make(map[string]map[string]string, len(tags))
+	for v, t := range tags {
+		if slices.Contains(requested, v) {
+			matched[v] = t
+			continue
+		}
+
+		k := normalizeARN(v)
+		if arn, ok := byNormalizedARN[k]; ok && !ambiguous[k] {
+			if _, ok := tags[arn]; !ok {
+				matched[arn] = t
+				continue
+			}
+		}
+
+		tflog.Debug(ctx, "Resource tags returned for unrequested ARN", map[string]any{
+			"arn": v,
+		})
+	}
+
+	for _, v := range requested {
+		if _, ok := matched[v]; !ok {
+			tflog.Debug(ctx, "No resource tags returned for requested ARN", map[string]any{
+				"arn": v,
+			})
+		}
+	}
+
+	return matched
+}
+
+// normalizeARN returns the specified ARN in lower case and without its partition, for comparison.
+func normalizeARN(v string) string {
+	if v, err := arn.Parse(v); err == nil {
+		v.Partition = ""
+		return strings.ToLower(v.String())
+	}
+
+	return strings.ToLower(v)
+}
+
+// batchFetchResult is the result of fetching the tags of a chunk of ARNs.
+type batchFetchResult struct {
+	tags    map[string]map[string]string
+	missing []string // The requested ARNs that GetResources didn't return.
+	err     error
+}
+
+// ListResourceTagsByTagFilter returns an iterator over pages of the tags, keyed by ARN, of resources
+// of the specified type that have all of the specified tags, using the Resource Groups Tagging API.
+// Each GetResources call is rate limited in the specified scope, which is that of the account and Region in which conn makes calls.
+func ListResourceTagsByTagFilter(ctx context.Context, conn resourcegroupstaggingapi.GetResourcesAPIClient, scope ratelimit.Scope, resourceType string, tags map[string]string) iter.Seq2[map[string]map[string]string, error] {
+	limiter := getResourcesRateLimiters.For(scope)
+	return listResourceTagsByTagFilter(ctx, conn, resourceType, tags, limiter.Wait, observeThrottling(limiter))
+}
+
+// listResourceTagsByTagFilter is ListResourceTagsByTagFilter with wait called before each GetResources call.
+func listResourceTagsByTagFilter(ctx context.Context, conn resourcegroupstaggingapi.GetResourcesAPIClient, resourceType string, tags map[string]string, wait func(context.Context) error, optFns ...func(*resourcegroupstaggingapi.Options)) iter.Seq2[map[string]map[string]string, error] {
+	return func(yield func(map[string]map[string]string, error) bool) {
+		input := resourcegroupstaggingapi.GetResourcesInput{
+			ResourceTypeFilters: []string{resourceType},
+		}
+		for _, k := range slices.Sorted(maps.Keys(tags)) {
+			input.TagFilters = append(input.TagFilters, awstypes.TagFilter{
+				Key:    aws.String(k),
+				Values: []string{tags[k]},
+			})
+		}
+
+		for page, err := range getResourcesPages(ctx, conn, &input, wait, optFns...) {
+			if err != nil {
+				yield(nil, fmt.Errorf("listing tagged resources: %w", err))
+				return
+			}
+
+			if !yield(page, nil) {
+				return
+			}
+		}
+	}
+}
+
+// getResourcesPages returns an iterator over pages of the tags, keyed by ARN, of the resources returned by GetResources,
+// either for the ARNs in input's ResourceARNList or for the resources matching its ResourceTypeFilters and TagFilters.
+// wait is called before each GetResources call, and throttled calls are retried with backoff.
+func getResourcesPages(ctx context.Context, conn resourcegroupstaggingapi.GetResourcesAPIClient, input *resourcegroupstaggingapi.GetResourcesInput, wait func(context.Context) error, optFns ...func(*resourcegroupstaggingapi.Options)) iter.Seq2[map[string]map[string]string, error] {
+	return func(yield func(map[string]map[string]string, error) bool) {
+		pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+		for pages.HasMorePages() {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			// Paginators don't advance on error, so a throttled page is requested again.
+			page, err := ratelimit.RetryThrottled(ctx, func(ctx context.Context) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+				if err := wait(ctx); err != nil {
+					return nil, err
+				}
+
+				return pages.NextPage(ctx, optFns...)
+			})
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(flattenResourceTagMappings(page.ResourceTagMappingList), nil) {
+				return
+			}
+		}
+	}
+}
+
+func flattenResourceTagMappings(apiObjects []awstypes.ResourceTagMapping) map[string]map[string]string {
+	tags := make(map[string]map[string]string, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		m := make(map[string]string, len(apiObject.Tags))
+		for _, tag := range apiObject.Tags {
+			m[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+		tags[aws.ToString(apiObject.ResourceARN)] = m
+	}
+
+	return tags
+}
+
+// getResourcesRateLimiters limit GetResources calls, which are throttled per account and Region.
+var getResourcesRateLimiters = ratelimit.Register("GetResources", 10, 10)
+
+// GetResourcesThrottles returns the number of throttled GetResources calls observed in the specified scope.
+func GetResourcesThrottles(scope ratelimit.Scope) int64 {
+	return getResourcesRateLimiters.For(scope).Throttles()
+}
+
+// observeThrottling returns an option that slows limiter down whenever a GetResources call is throttled.
+func observeThrottling(limiter *ratelimit.Limiter) func(*resourcegroupstaggingapi.Options) {
+	return func(o *resourcegroupstaggingapi.Options) {
+		if r, ok := o.Retryer.(aws.RetryerV2); ok {
+			o.Retryer = limiter.ObserveThrottling(r)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"maps"
+	"sync"
+	"time"
+)
+
+const (
+	// Cached tags are reused for a short time only, as they may be changed outside Terraform.
+	batchTagCacheTTL = 1 * time.Minute
+
+	// The maximum number of resources whose tags are cached.
+	batchTagCacheMaxSize = 10000
+)
+
+// batchTagCache caches the tags fetched by BatchFetchResourceTags, so that list resources run in the same
+// Terraform operation don't fetch the tags of the same resources more than once.
+// ARNs include the account and Region, so a single cache is shared by all accounts and Regions.
+var batchTagCache = newTagCache(batchTagCacheTTL, batchTagCacheMaxSize)
+
+// tagCache is a concurrency-safe cache of resource tags, keyed by ARN, whose entries expire after a fixed time.
+type tagCache struct {
+	mutex   sync.Mutex
+	ttl     time.Duration
+	maxSize int
+	entries map[string]tagCacheEntry
+	now     func() time.Time
+}
+
+type tagCacheEntry struct {
+	tags    map[string]string
+	expires time.Time
+}
+
+func newTagCache(ttl time.Duration, maxSize int) *tagCache {
+	return &tagCache{
+		ttl:     ttl,
+		maxSize: maxSize,
+		entries: make(map[string]tagCacheEntry),
+		now:     time.Now,
+	}
+}
+
+// get returns the cached tags of the specified resource, if they haven't expired.
+func (c *tagCache) get(arn string) (map[string]string, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[arn]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, arn)
+		return nil, false
+	}
+
+	return maps.Clone(entry.tags), true
+}
+
+// put caches the tags of the specified resources, keyed by ARN.
+// Once the cache is full, expired entries are evicted, and if it is still full no more resources are cached.
+func (c *tagCache) put(tags map[string]map[string]string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := c.now()
+	expires := now.Add(c.ttl)
+	for arn, v := range tags {
+		if _, ok := c.entries[arn]; !ok && len(c.entries) >= c.maxSize {
+			maps.DeleteFunc(c.entries, func(_ string, entry tagCacheEntry) bool {
+				return !now.Before(entry.expires)
+			})
+			if len(c.entries) >= c.maxSize {
+				return
+			}
+		}
+
+		c.entries[arn] = tagCacheEntry{
+			tags:    maps.Clone(v),
+			expires: expires,
+		}
+	}
+}
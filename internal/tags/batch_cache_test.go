@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBatchFetchResourceTags_cached(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockGetResourcesClient{
+		tags: map[string]map[string]string{
+			"arn:aws:sqs:us-west-2:123456789012:queue1": { //lintignore:AWSAT003,AWSAT005
+				"key1": "value1",
+			},
+			"arn:aws:sqs:us-west-2:123456789012:queue2": { //lintignore:AWSAT003,AWSAT005
+				"key2": "value2",
+			},
+		},
+	}
+	cache := newTagCache(batchTagCacheTTL, batchTagCacheMaxSize)
+	wait := func(context.Context) error {
+		return nil
+	}
+
+	if _, err := batchFetchResourceTags(t.Context(), conn, cache, []string{
+		"arn:aws:sqs:us-west-2:123456789012:queue1", //lintignore:AWSAT003,AWSAT005
+	}, wait); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := batchFetchResourceTags(t.Context(), conn, cache, []string{
+		"arn:aws:sqs:us-west-2:123456789012:queue1", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sqs:us-west-2:123456789012:queue2", //lintignore:AWSAT003,AWSAT005
+	}, wait)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]map[string]string{
+		"arn:aws:sqs:us-west-2:123456789012:queue1": { //lintignore:AWSAT003,AWSAT005
+			"key1": "value1",
+		},
+		"arn:aws:sqs:us-west-2:123456789012:queue2": { //lintignore:AWSAT003,AWSAT005
+			"key2": "value2",
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	// The cached ARN isn't requested again.
+	wantCalls := [][]string{
+		{"arn:aws:sqs:us-west-2:123456789012:queue1"}, //lintignore:AWSAT003,AWSAT005
+		{"arn:aws:sqs:us-west-2:123456789012:queue2"}, //lintignore:AWSAT003,AWSAT005
+	}
+	if diff := cmp.Diff(conn.calls, wantCalls); diff != "" {
+		t.Errorf("unexpected GetResources calls (+want, -got): %s", diff)
+	}
+
+	// A lookup for only cached ARNs makes no GetResources call.
+	if _, err := batchFetchResourceTags(t.Context(), conn, cache, []string{
+		"arn:aws:sqs:us-west-2:123456789012:queue2", //lintignore:AWSAT003,AWSAT005
+	}, wait); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := len(conn.calls), len(wantCalls); got != want {
+		t.Errorf("expected %d GetResources calls, got %d", want, got)
+	}
+}
+
+func TestBatchFetchResourceTags_cachedMissing(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockGetResourcesClient{
+		tags: map[string]map[string]string{
+			"arn:aws:sqs:us-west-2:123456789012:queue1": { //lintignore:AWSAT003,AWSAT005
+				"key1": "value1",
+			},
+		},
+	}
+	cache := newTagCache(batchTagCacheTTL, batchTagCacheMaxSize)
+	wait := func(context.Context) error {
+		return nil
+	}
+
+	// queue2 is untagged, so GetResources doesn't return it.
+	if _, err := batchFetchResourceTags(t.Context(), conn, cache, []string{
+		"arn:aws:sqs:us-west-2:123456789012:queue1", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sqs:us-west-2:123456789012:queue2", //lintignore:AWSAT003,AWSAT005
+	}, wait); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := batchFetchResourceTags(t.Context(), conn, cache, []string{
+		"arn:aws:sqs:us-west-2:123456789012:queue2", //lintignore:AWSAT003,AWSAT005
+	}, wait)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]map[string]string{
+		"arn:aws:sqs:us-west-2:123456789012:queue2": {}, //lintignore:AWSAT003,AWSAT005
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	// The missing ARN is cached too, so isn't requested again.
+	if got, want := len(conn.calls), 1; got != want {
+		t.Errorf("expected %d GetResources calls, got %d", want, got)
+	}
+}
+
+func TestTagCache_expiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := newTagCache(time.Minute, 10)
+	cache.now = func() time.Time {
+		return now
+	}
+
+	cache.put(map[string]map[string]string{
+		"arn1": {"key1": "value1"},
+	})
+
+	now = now.Add(59 * time.Second)
+	if _, ok := cache.get("arn1"); !ok {
+		t.Errorf("expected cached tags before expiry")
+	}
+
+	now = now.Add(time.Second)
+	if _, ok := cache.get("arn1"); ok {
+		t.Errorf("expected no cached tags after expiry")
+	}
+}
+
+func TestTagCache_maxSize(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := newTagCache(time.Minute, 2)
+	cache.now = func() time.Time {
+		return now
+	}
+
+	cache.put(map[string]map[string]string{
+		"arn1": {},
+		"arn2": {},
+	})
+	cache.put(map[string]map[string]string{
+		"arn3": {},
+	})
+
+	if _, ok := cache.get("arn3"); ok {
+		t.Errorf("expected no tags cached beyond the maximum size")
+	}
+
+	// Expired entries make room.
+	now = now.Add(time.Minute)
+	cache.put(map[string]map[string]string{
+		"arn3": {},
+	})
+
+	if _, ok := cache.get("arn3"); !ok {
+		t.Errorf("expected cached tags once expired entries are evicted")
+	}
+	if got := len(cache.entries); got != 1 {
+		t.Errorf("expected 1 cached entry, got %d", got)
+	}
+}
@@ -0,0 +1,553 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+)
+
+func TestBatchFetchResourceTags(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockGetResourcesClient{
+		tags: map[string]map[string]string{
+			"arn:aws:sqs:us-west-2:123456789012:queue1": { //lintignore:AWSAT003,AWSAT005
+				"key1": "value1",
+			},
+			"arn:aws:sqs:us-west-2:123456789012:queue2": {}, //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	got, err := BatchFetchResourceTags(t.Context(), conn, ratelimit.Scope{AccountID: "123456789012", Region: "us-west-2"}, []string{ //lintignore:AWSAT003
+		"arn:aws:sqs:us-west-2:123456789012:queue1", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sqs:us-west-2:123456789012:queue2", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sqs:us-west-2:123456789012:queue3", //lintignore:AWSAT003,AWSAT005
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]map[string]string{
+		"arn:aws:sqs:us-west-2:123456789012:queue1": { //lintignore:AWSAT003,AWSAT005
+			"key1": "value1",
+		},
+		"arn:aws:sqs:us-west-2:123456789012:queue2": {}, //lintignore:AWSAT003,AWSAT005
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestBatchFetchResourceTags_chunking(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arnCount           int
+		expectedChunkSizes []int
+	}{
+		"no ARNs": {
+			arnCount:           0,
+			expectedChunkSizes: nil,
+		},
+		"one ARN": {
+			arnCount:           1,
+			expectedChunkSizes: []int{1},
+		},
+		"one chunk": {
+			arnCount:           100,
+			expectedChunkSizes: []int{100},
+		},
+		"one more than a chunk": {
+			arnCount:           101,
+			expectedChunkSizes: []int{100, 1},
+		},
+		"several chunks": {
+			arnCount:           250,
+			expectedChunkSizes: []int{100, 100, 50},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := &mockGetResourcesClient{
+				tags: make(map[string]map[string]string),
+			}
+			arns := make([]string, 0, testCase.arnCount)
+			for i := range testCase.arnCount {
+				arn := fmt.Sprintf("arn:aws:sqs:us-west-2:123456789012:queue%d", i) //lintignore:AWSAT003,AWSAT005
+				arns = append(arns, arn)
+				conn.tags[arn] = map[string]string{
+					"index": strconv.Itoa(i),
+				}
+			}
+
+			wait := func(context.Context) error {
+				return nil
+			}
+
+			got, err := batchFetchResourceTags(t.Context(), conn, newTagCache(batchTagCacheTTL, batchTagCacheMaxSize), arns, wait)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var chunkSizes []int
+			for _, call := range conn.calls {
+				chunkSizes = append(chunkSizes, len(call))
+			}
+			// Chunks are fetched concurrently, so may be requested in any order.
+			if diff := cmp.Diff(chunkSizes, testCase.expectedChunkSizes, cmpopts.SortSlices(func(a, b int) bool { return a > b })); diff != "" {
+				t.Errorf("unexpected chunk sizes (+want, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, conn.tags); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBatchFetchResourceTags_concurrency(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockGetResourcesClient{
+		tags: make(map[string]map[string]string),
+	}
+	arns := make([]string, 0, 250)
+	for i := range 250 {
+		arn := fmt.Sprintf("arn:aws:sqs:us-west-2:123456789012:queue%d", i) //lintignore:AWSAT003,AWSAT005
+		arns = append(arns, arn)
+		conn.tags[arn] = map[string]string{
+			"index": strconv.Itoa(i),
+		}
+	}
+
+	// The first chunk doesn't complete until the last chunk has.
+	client := &outOfOrderGetResourcesClient{
+		mockGetResourcesClient: conn,
+		first:                  arns[0],
+		last:                   arns[len(arns)-1],
+		release:                make(chan struct{}),
+	}
+	wait := func(context.Context) error {
+		return nil
+	}
+
+	got, err := batchFetchResourceTags(t.Context(), client, newTagCache(batchTagCacheTTL, batchTagCacheMaxSize), arns, wait)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, conn.tags); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+// outOfOrderGetResourcesClient holds back the call for the chunk containing first until the call for the chunk containing last has completed.
+type outOfOrderGetResourcesClient struct {
+	*mockGetResourcesClient
+	first, last string
+	release     chan struct{}
+}
+
+func (c *outOfOrderGetResourcesClient) GetResources(ctx context.Context, input *resourcegroupstaggingapi.GetResourcesInput, optFns ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	if slices.Contains(input.ResourceARNList, c.first) {
+		select {
+		case <-c.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	output, err := c.mockGetResourcesClient.GetResources(ctx, input, optFns...)
+
+	if slices.Contains(input.ResourceARNList, c.last) {
+		close(c.release)
+	}
+
+	return output, err
+}
+
+func TestBatchFetchResourceTags_arnMismatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		requested []string
+		returned  map[string]string // Requested ARN to returned ARN.
+		expected  map[string]map[string]string
+	}{
+		"GovCloud partition": {
+			requested: []string{
+				"arn:aws:logs:us-gov-west-1:123456789012:log-group:a", //lintignore:AWSAT003,AWSAT005
+			},
+			returned: map[string]string{
+				"arn:aws:logs:us-gov-west-1:123456789012:log-group:a": "arn:aws-us-gov:logs:us-gov-west-1:123456789012:log-group:a", //lintignore:AWSAT003,AWSAT005
+			},
+			expected: map[string]map[string]string{
+				"arn:aws:logs:us-gov-west-1:123456789012:log-group:a": { //lintignore:AWSAT003,AWSAT005
+					"key": "value",
+				},
+			},
+		},
+		"China partition and case": {
+			requested: []string{
+				"arn:aws-cn:s3:::Bucket", //lintignore:AWSAT005
+			},
+			returned: map[string]string{
+				"arn:aws-cn:s3:::Bucket": "arn:aws-cn:s3:::bucket", //lintignore:AWSAT005
+			},
+			expected: map[string]map[string]string{
+				"arn:aws-cn:s3:::Bucket": { //lintignore:AWSAT005
+					"key": "value",
+				},
+			},
+		},
+		"exact match preferred": {
+			requested: []string{
+				"arn:aws-cn:logs:cn-north-1:123456789012:log-group:a", //lintignore:AWSAT003,AWSAT005
+				"arn:aws-cn:logs:cn-north-1:123456789012:log-group:b", //lintignore:AWSAT003,AWSAT005
+			},
+			returned: map[string]string{
+				"arn:aws-cn:logs:cn-north-1:123456789012:log-group:b": "arn:aws:logs:cn-north-1:123456789012:log-group:a", //lintignore:AWSAT003,AWSAT005
+			},
+			expected: map[string]map[string]string{
+				"arn:aws-cn:logs:cn-north-1:123456789012:log-group:a": { //lintignore:AWSAT003,AWSAT005
+					"key": "value",
+				},
+			},
+		},
+		"ambiguous": {
+			requested: []string{
+				"arn:aws:logs:us-west-2:123456789012:log-group:A", //lintignore:AWSAT003,AWSAT005
+				"arn:aws:logs:us-west-2:123456789012:log-group:a", //lintignore:AWSAT003,AWSAT005
+			},
+			returned: map[string]string{
+				"arn:aws:logs:us-west-2:123456789012:log-group:A": "arn:aws-us-gov:logs:us-west-2:123456789012:log-group:A", //lintignore:AWSAT003,AWSAT005
+				"arn:aws:logs:us-west-2:123456789012:log-group:a": "arn:aws-us-gov:logs:us-west-2:123456789012:log-group:a", //lintignore:AWSAT003,AWSAT005
+			},
+			expected: map[string]map[string]string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := &renamingGetResourcesClient{
+				mockGetResourcesClient: &mockGetResourcesClient{
+					tags: make(map[string]map[string]string),
+				},
+				resourceARNs: testCase.returned,
+			}
+			for _, arn := range testCase.requested {
+				conn.tags[arn] = map[string]string{
+					"key": "value",
+				}
+			}
+			wait := func(context.Context) error {
+				return nil
+			}
+
+			got, err := batchFetchResourceTags(t.Context(), conn, newTagCache(batchTagCacheTTL, batchTagCacheMaxSize), testCase.requested, wait)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+// renamingGetResourcesClient returns the requested resources with the ARNs in resourceARNs, keyed by requested ARN.
+type renamingGetResourcesClient struct {
+	*mockGetResourcesClient
+	resourceARNs map[string]string
+}
+
+func (c *renamingGetResourcesClient) GetResources(ctx context.Context, input *resourcegroupstaggingapi.GetResourcesInput, optFns ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	output, err := c.mockGetResourcesClient.GetResources(ctx, input, optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range output.ResourceTagMappingList {
+		if arn, ok := c.resourceARNs[aws.ToString(v.ResourceARN)]; ok {
+			output.ResourceTagMappingList[i].ResourceARN = aws.String(arn)
+		}
+	}
+
+	return output, nil
+}
+
+func TestBatchFetchResourceTags_rateLimit(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arnCount      int
+		pageSize      int
+		expectedWaits int
+	}{
+		"no ARNs": {
+			arnCount:      0,
+			expectedWaits: 0,
+		},
+		"one page": {
+			arnCount:      10,
+			expectedWaits: 1,
+		},
+		"several pages": {
+			arnCount:      10,
+			pageSize:      3,
+			expectedWaits: 4,
+		},
+		"several chunks of several pages": {
+			arnCount:      150,
+			pageSize:      40,
+			expectedWaits: 5,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := &mockGetResourcesClient{
+				tags:     make(map[string]map[string]string),
+				pageSize: testCase.pageSize,
+			}
+			arns := make([]string, 0, testCase.arnCount)
+			for i := range testCase.arnCount {
+				arn := fmt.Sprintf("arn:aws:sqs:us-west-2:123456789012:queue%d", i) //lintignore:AWSAT003,AWSAT005
+				arns = append(arns, arn)
+				conn.tags[arn] = map[string]string{
+					"index": strconv.Itoa(i),
+				}
+			}
+
+			var count atomic.Int64
+			wait := func(context.Context) error {
+				count.Add(1)
+				return nil
+			}
+
+			got, err := batchFetchResourceTags(t.Context(), conn, newTagCache(batchTagCacheTTL, batchTagCacheMaxSize), arns, wait)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			waits := int(count.Load())
+			if waits != len(conn.calls) {
+				t.Errorf("expected one wait per page, got %d waits for %d pages", waits, len(conn.calls))
+			}
+			if waits != testCase.expectedWaits {
+				t.Errorf("expected %d waits, got %d", testCase.expectedWaits, waits)
+			}
+
+			if diff := cmp.Diff(got, conn.tags); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBatchFetchResourceTags_rateLimitError(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockGetResourcesClient{}
+	wait := func(context.Context) error {
+		return context.Canceled
+	}
+
+	_, err := batchFetchResourceTags(t.Context(), conn, newTagCache(batchTagCacheTTL, batchTagCacheMaxSize), []string{
+		"arn:aws:sqs:us-west-2:123456789012:queue1", //lintignore:AWSAT003,AWSAT005
+	}, wait)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %s, got %v", context.Canceled, err)
+	}
+
+	if len(conn.calls) != 0 {
+		t.Errorf("expected no GetResources calls, got %d", len(conn.calls))
+	}
+}
+
+func TestBatchFetchResourceTags_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	conn := &mockGetResourcesClient{}
+	wait := func(context.Context) error {
+		return nil
+	}
+
+	_, err := batchFetchResourceTags(ctx, conn, newTagCache(batchTagCacheTTL, batchTagCacheMaxSize), []string{
+		"arn:aws:sqs:us-west-2:123456789012:queue1", //lintignore:AWSAT003,AWSAT005
+	}, wait)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %s, got %v", context.Canceled, err)
+	}
+
+	if len(conn.calls) != 0 {
+		t.Errorf("expected no GetResources calls, got %d", len(conn.calls))
+	}
+}
+
+func TestListResourceTagsByTagFilter(t *testing.T) {
+	t.Parallel()
+
+	conn := &mockGetResourcesClient{
+		tags: map[string]map[string]string{
+			"arn:aws:logs:us-west-2:123456789012:log-group:a": { //lintignore:AWSAT003,AWSAT005
+				"team": "payments",
+				"env":  "prod",
+			},
+			"arn:aws:logs:us-west-2:123456789012:log-group:b": { //lintignore:AWSAT003,AWSAT005
+				"team": "payments",
+			},
+			"arn:aws:logs:us-west-2:123456789012:log-group:c": { //lintignore:AWSAT003,AWSAT005
+				"team": "search",
+				"env":  "prod",
+			},
+			"arn:aws:logs:us-west-2:123456789012:log-group:d": { //lintignore:AWSAT003,AWSAT005
+				"team": "payments",
+				"env":  "prod",
+			},
+			"arn:aws:sqs:us-west-2:123456789012:queue1": { //lintignore:AWSAT003,AWSAT005
+				"team": "payments",
+				"env":  "prod",
+			},
+		},
+		pageSize: 1,
+	}
+
+	var waits int
+	wait := func(context.Context) error {
+		waits++
+		return nil
+	}
+
+	var pages []map[string]map[string]string
+	for page, err := range listResourceTagsByTagFilter(t.Context(), conn, "logs:log-group", map[string]string{"team": "payments", "env": "prod"}, wait) {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		pages = append(pages, page)
+	}
+
+	want := []map[string]map[string]string{
+		{
+			"arn:aws:logs:us-west-2:123456789012:log-group:a": { //lintignore:AWSAT003,AWSAT005
+				"team": "payments",
+				"env":  "prod",
+			},
+		},
+		{
+			"arn:aws:logs:us-west-2:123456789012:log-group:d": { //lintignore:AWSAT003,AWSAT005
+				"team": "payments",
+				"env":  "prod",
+			},
+		},
+	}
+	if diff := cmp.Diff(pages, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	if waits != len(conn.calls) {
+		t.Errorf("expected one wait per page, got %d waits for %d pages", waits, len(conn.calls))
+	}
+}
+
+// mockGetResourcesClient returns the tags of the requested ARNs or,
+// if no ARNs are requested, of the resources matching the type and tag filters.
+// A non-zero pageSize splits the results into pages of at most pageSize resources.
+type mockGetResourcesClient struct {
+	tags     map[string]map[string]string
+	pageSize int
+
+	mutex sync.Mutex
+	calls [][]string
+}
+
+func (c *mockGetResourcesClient) GetResources(_ context.Context, input *resourcegroupstaggingapi.GetResourcesInput, _ ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	c.mutex.Lock()
+	c.calls = append(c.calls, input.ResourceARNList)
+	c.mutex.Unlock()
+
+	arns := input.ResourceARNList
+	if len(arns) == 0 {
+		arns = c.filter(input.ResourceTypeFilters, input.TagFilters)
+	}
+	var output resourcegroupstaggingapi.GetResourcesOutput
+	if c.pageSize > 0 {
+		start, _ := strconv.Atoi(aws.ToString(input.PaginationToken))
+		end := min(start+c.pageSize, len(arns))
+		if end < len(arns) {
+			output.PaginationToken = aws.String(strconv.Itoa(end))
+		}
+		arns = arns[start:end]
+	}
+
+	for _, arn := range arns {
+		tags, ok := c.tags[arn]
+		if !ok {
+			continue
+		}
+
+		mapping := awstypes.ResourceTagMapping{
+			ResourceARN: aws.String(arn),
+		}
+		for k, v := range tags {
+			mapping.Tags = append(mapping.Tags, awstypes.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+		output.ResourceTagMappingList = append(output.ResourceTagMappingList, mapping)
+	}
+
+	return &output, nil
+}
+
+func (c *mockGetResourcesClient) filter(resourceTypes []string, tagFilters []awstypes.TagFilter) []string {
+	var arns []string
+
+	for _, arn := range slices.Sorted(maps.Keys(c.tags)) {
+		// arn:partition:service:region:account-id:resource-type...
+		parts := strings.SplitN(arn, ":", 6)
+		if !slices.ContainsFunc(resourceTypes, func(resourceType string) bool {
+			service, typ, _ := strings.Cut(resourceType, ":")
+			return parts[2] == service && strings.HasPrefix(parts[5], typ)
+		}) {
+			continue
+		}
+		if !slices.ContainsFunc(tagFilters, func(filter awstypes.TagFilter) bool {
+			v, ok := c.tags[arn][aws.ToString(filter.Key)]
+			return !ok || !slices.Contains(filter.Values, v)
+		}) {
+			arns = append(arns, arn)
+		}
+	}
+
+	return arns
+}